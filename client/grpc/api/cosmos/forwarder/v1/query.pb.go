@@ -7,10 +7,12 @@ import (
 	context "context"
 	fmt "fmt"
 	p2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
-	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -259,26 +261,24 @@ func (m *GetLatestValidatorSetResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// Validator is the type for the validator-set.
-type Validator struct {
-	Address          string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	PubKey           *types.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	VotingPower      int64      `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
-	ProposerPriority int64      `protobuf:"varint,4,opt,name=proposer_priority,json=proposerPriority,proto3" json:"proposer_priority,omitempty"`
+// GetBlockByHashRequest is the request type for the Query/GetBlockByHash RPC method.
+type GetBlockByHashRequest struct {
+	// hash is the hex encoded block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *Validator) Reset()         { *m = Validator{} }
-func (m *Validator) String() string { return proto.CompactTextString(m) }
-func (*Validator) ProtoMessage()    {}
-func (*Validator) Descriptor() ([]byte, []int) {
+func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
+func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByHashRequest) ProtoMessage()    {}
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6616aa04c2c794d7, []int{4}
 }
-func (m *Validator) XXX_Unmarshal(b []byte) error {
+func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBlockByHashRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -288,63 +288,137 @@ func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Validator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Validator.Merge(m, src)
+func (m *GetBlockByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByHashRequest.Merge(m, src)
 }
-func (m *Validator) XXX_Size() int {
+func (m *GetBlockByHashRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Validator) XXX_DiscardUnknown() {
-	xxx_messageInfo_Validator.DiscardUnknown(m)
+func (m *GetBlockByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByHashRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Validator proto.InternalMessageInfo
+var xxx_messageInfo_GetBlockByHashRequest proto.InternalMessageInfo
 
-func (m *Validator) GetAddress() string {
+func (m *GetBlockByHashRequest) GetHash() string {
 	if m != nil {
-		return m.Address
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *Validator) GetPubKey() *types.Any {
+// GetBlockByHashResponse is the response type for the Query/GetBlockByHash RPC method.
+type GetBlockByHashResponse struct {
+	Block *IndexedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *GetBlockByHashResponse) Reset()         { *m = GetBlockByHashResponse{} }
+func (m *GetBlockByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByHashResponse) ProtoMessage()    {}
+func (*GetBlockByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{5}
+}
+func (m *GetBlockByHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockByHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByHashResponse.Merge(m, src)
+}
+func (m *GetBlockByHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockByHashResponse proto.InternalMessageInfo
+
+func (m *GetBlockByHashResponse) GetBlock() *IndexedBlock {
 	if m != nil {
-		return m.PubKey
+		return m.Block
 	}
 	return nil
 }
 
-func (m *Validator) GetVotingPower() int64 {
-	if m != nil {
-		return m.VotingPower
+// GetTxByHashRequest is the request type for the Query/GetTxByHash RPC method.
+type GetTxByHashRequest struct {
+	// hash is the hex encoded transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetTxByHashRequest) Reset()         { *m = GetTxByHashRequest{} }
+func (m *GetTxByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxByHashRequest) ProtoMessage()    {}
+func (*GetTxByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{6}
+}
+func (m *GetTxByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *GetTxByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxByHashRequest.Merge(m, src)
+}
+func (m *GetTxByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxByHashRequest.DiscardUnknown(m)
 }
 
-func (m *Validator) GetProposerPriority() int64 {
+var xxx_messageInfo_GetTxByHashRequest proto.InternalMessageInfo
+
+func (m *GetTxByHashRequest) GetHash() string {
 	if m != nil {
-		return m.ProposerPriority
+		return m.Hash
 	}
-	return 0
+	return ""
 }
 
-// GetBlockByHeightRequest is the request type for the Query/GetBlockByHeight RPC method.
-type GetBlockByHeightRequest struct {
-	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+// GetTxByHashResponse is the response type for the Query/GetTxByHash RPC method.
+type GetTxByHashResponse struct {
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// index is the position of the transaction in the block.
+	Index   uint32         `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	BlockId *types.BlockID `protobuf:"bytes,4,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
 }
 
-func (m *GetBlockByHeightRequest) Reset()         { *m = GetBlockByHeightRequest{} }
-func (m *GetBlockByHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBlockByHeightRequest) ProtoMessage()    {}
-func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{5}
+func (m *GetTxByHashResponse) Reset()         { *m = GetTxByHashResponse{} }
+func (m *GetTxByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxByHashResponse) ProtoMessage()    {}
+func (*GetTxByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{7}
 }
-func (m *GetBlockByHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetTxByHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBlockByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTxByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBlockByHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTxByHashResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -354,46 +428,66 @@ func (m *GetBlockByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetBlockByHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockByHeightRequest.Merge(m, src)
+func (m *GetTxByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxByHashResponse.Merge(m, src)
 }
-func (m *GetBlockByHeightRequest) XXX_Size() int {
+func (m *GetTxByHashResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBlockByHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockByHeightRequest.DiscardUnknown(m)
+func (m *GetTxByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxByHashResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockByHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTxByHashResponse proto.InternalMessageInfo
 
-func (m *GetBlockByHeightRequest) GetHeight() int64 {
+func (m *GetTxByHashResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetTxByHashResponse) GetHeight() int64 {
 	if m != nil {
 		return m.Height
 	}
 	return 0
 }
 
-// GetBlockByHeightResponse is the response type for the Query/GetBlockByHeight RPC method.
-type GetBlockByHeightResponse struct {
-	BlockId *types1.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	// Deprecated: please use `sdk_block` instead
-	Block *types1.Block `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
-	// Since: cosmos-sdk 0.47
-	SdkBlock *Block `protobuf:"bytes,3,opt,name=sdk_block,json=sdkBlock,proto3" json:"sdk_block,omitempty"`
+func (m *GetTxByHashResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *GetBlockByHeightResponse) Reset()         { *m = GetBlockByHeightResponse{} }
-func (m *GetBlockByHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBlockByHeightResponse) ProtoMessage()    {}
-func (*GetBlockByHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{6}
+func (m *GetTxByHashResponse) GetBlockId() *types.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
 }
-func (m *GetBlockByHeightResponse) XXX_Unmarshal(b []byte) error {
+
+// IndexedBlock is a block as stored by the local indexer.
+type IndexedBlock struct {
+	BlockId *types.BlockID    `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Header  *tmservice.Header `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// tx_hashes are the upper-case hex encoded hashes of the block transactions in block order.
+	TxHashes []string `protobuf:"bytes,3,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+}
+
+func (m *IndexedBlock) Reset()         { *m = IndexedBlock{} }
+func (m *IndexedBlock) String() string { return proto.CompactTextString(m) }
+func (*IndexedBlock) ProtoMessage()    {}
+func (*IndexedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{8}
+}
+func (m *IndexedBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBlockByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IndexedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBlockByHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IndexedBlock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -403,55 +497,60 @@ func (m *GetBlockByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *GetBlockByHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBlockByHeightResponse.Merge(m, src)
+func (m *IndexedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedBlock.Merge(m, src)
 }
-func (m *GetBlockByHeightResponse) XXX_Size() int {
+func (m *IndexedBlock) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBlockByHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBlockByHeightResponse.DiscardUnknown(m)
+func (m *IndexedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBlockByHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_IndexedBlock proto.InternalMessageInfo
 
-func (m *GetBlockByHeightResponse) GetBlockId() *types1.BlockID {
+func (m *IndexedBlock) GetBlockId() *types.BlockID {
 	if m != nil {
 		return m.BlockId
 	}
 	return nil
 }
 
-func (m *GetBlockByHeightResponse) GetBlock() *types1.Block {
+func (m *IndexedBlock) GetHeader() *tmservice.Header {
 	if m != nil {
-		return m.Block
+		return m.Header
 	}
 	return nil
 }
 
-func (m *GetBlockByHeightResponse) GetSdkBlock() *Block {
+func (m *IndexedBlock) GetTxHashes() []string {
 	if m != nil {
-		return m.SdkBlock
+		return m.TxHashes
 	}
 	return nil
 }
 
-// GetLatestBlockRequest is the request type for the Query/GetLatestBlock RPC method.
-type GetLatestBlockRequest struct {
+// GetBlockRangeRequest is the request type for the Query/GetBlockRange RPC method.
+type GetBlockRangeRequest struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// concurrency is the maximum number of blocks fetched at the same time.
+	// Zero or a value above the server limit means the server limit.
+	Concurrency uint32 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
 }
 
-func (m *GetLatestBlockRequest) Reset()         { *m = GetLatestBlockRequest{} }
-func (m *GetLatestBlockRequest) String() string { return proto.CompactTextString(m) }
-func (*GetLatestBlockRequest) ProtoMessage()    {}
-func (*GetLatestBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{7}
+func (m *GetBlockRangeRequest) Reset()         { *m = GetBlockRangeRequest{} }
+func (m *GetBlockRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRangeRequest) ProtoMessage()    {}
+func (*GetBlockRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{9}
 }
-func (m *GetLatestBlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetBlockRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetLatestBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetLatestBlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBlockRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -461,39 +560,61 @@ func (m *GetLatestBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetLatestBlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLatestBlockRequest.Merge(m, src)
+func (m *GetBlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRangeRequest.Merge(m, src)
 }
-func (m *GetLatestBlockRequest) XXX_Size() int {
+func (m *GetBlockRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetLatestBlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLatestBlockRequest.DiscardUnknown(m)
+func (m *GetBlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLatestBlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetBlockRangeRequest proto.InternalMessageInfo
 
-// GetLatestBlockResponse is the response type for the Query/GetLatestBlock RPC method.
-type GetLatestBlockResponse struct {
-	BlockId *types1.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+func (m *GetBlockRangeRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetBlockRangeRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *GetBlockRangeRequest) GetConcurrency() uint32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+// GetBlockRangeResponse is the response type for the Query/GetBlockRange RPC method.
+// Each message holds one block.
+type GetBlockRangeResponse struct {
+	Height  int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockId *types.BlockID `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
 	// Deprecated: please use `sdk_block` instead
-	Block *types1.Block `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
-	// Since: cosmos-sdk 0.47
-	SdkBlock *Block `protobuf:"bytes,3,opt,name=sdk_block,json=sdkBlock,proto3" json:"sdk_block,omitempty"`
+	Block    *types.Block `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	SdkBlock *Block       `protobuf:"bytes,4,opt,name=sdk_block,json=sdkBlock,proto3" json:"sdk_block,omitempty"`
 }
 
-func (m *GetLatestBlockResponse) Reset()         { *m = GetLatestBlockResponse{} }
-func (m *GetLatestBlockResponse) String() string { return proto.CompactTextString(m) }
-func (*GetLatestBlockResponse) ProtoMessage()    {}
-func (*GetLatestBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{8}
+func (m *GetBlockRangeResponse) Reset()         { *m = GetBlockRangeResponse{} }
+func (m *GetBlockRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRangeResponse) ProtoMessage()    {}
+func (*GetBlockRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{10}
 }
-func (m *GetLatestBlockResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetBlockRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetLatestBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBlockRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetLatestBlockResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBlockRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -503,55 +624,67 @@ func (m *GetLatestBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetLatestBlockResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLatestBlockResponse.Merge(m, src)
+func (m *GetBlockRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRangeResponse.Merge(m, src)
 }
-func (m *GetLatestBlockResponse) XXX_Size() int {
+func (m *GetBlockRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetLatestBlockResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLatestBlockResponse.DiscardUnknown(m)
+func (m *GetBlockRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLatestBlockResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetBlockRangeResponse proto.InternalMessageInfo
+
+func (m *GetBlockRangeResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
 
-func (m *GetLatestBlockResponse) GetBlockId() *types1.BlockID {
+func (m *GetBlockRangeResponse) GetBlockId() *types.BlockID {
 	if m != nil {
 		return m.BlockId
 	}
 	return nil
 }
 
-func (m *GetLatestBlockResponse) GetBlock() *types1.Block {
+func (m *GetBlockRangeResponse) GetBlock() *types.Block {
 	if m != nil {
 		return m.Block
 	}
 	return nil
 }
 
-func (m *GetLatestBlockResponse) GetSdkBlock() *Block {
+func (m *GetBlockRangeResponse) GetSdkBlock() *Block {
 	if m != nil {
 		return m.SdkBlock
 	}
 	return nil
 }
 
-// GetSyncingRequest is the request type for the Query/GetSyncing RPC method.
-type GetSyncingRequest struct {
+// GetFullValidatorSetRequest is the request type for the Query/GetFullValidatorSet
+// and Query/StreamValidatorSet RPC methods.
+type GetFullValidatorSetRequest struct {
+	// height is the block height of the validator-set. Zero means the latest height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// page_size is the number of validators fetched per upstream call. Zero means 100.
+	PageSize uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (m *GetSyncingRequest) Reset()         { *m = GetSyncingRequest{} }
-func (m *GetSyncingRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSyncingRequest) ProtoMessage()    {}
-func (*GetSyncingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{9}
+func (m *GetFullValidatorSetRequest) Reset()         { *m = GetFullValidatorSetRequest{} }
+func (m *GetFullValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFullValidatorSetRequest) ProtoMessage()    {}
+func (*GetFullValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{11}
 }
-func (m *GetSyncingRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetFullValidatorSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSyncingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFullValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSyncingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFullValidatorSetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -561,35 +694,50 @@ func (m *GetSyncingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetSyncingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSyncingRequest.Merge(m, src)
+func (m *GetFullValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFullValidatorSetRequest.Merge(m, src)
 }
-func (m *GetSyncingRequest) XXX_Size() int {
+func (m *GetFullValidatorSetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSyncingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSyncingRequest.DiscardUnknown(m)
+func (m *GetFullValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFullValidatorSetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSyncingRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetFullValidatorSetRequest proto.InternalMessageInfo
 
-// GetSyncingResponse is the response type for the Query/GetSyncing RPC method.
-type GetSyncingResponse struct {
-	Syncing bool `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+func (m *GetFullValidatorSetRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *GetSyncingResponse) Reset()         { *m = GetSyncingResponse{} }
-func (m *GetSyncingResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSyncingResponse) ProtoMessage()    {}
-func (*GetSyncingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{10}
+func (m *GetFullValidatorSetRequest) GetPageSize() uint64 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
-func (m *GetSyncingResponse) XXX_Unmarshal(b []byte) error {
+
+// GetFullValidatorSetResponse is the response type for the Query/GetFullValidatorSet RPC method.
+type GetFullValidatorSetResponse struct {
+	BlockHeight int64        `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Validators  []*Validator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *GetFullValidatorSetResponse) Reset()         { *m = GetFullValidatorSetResponse{} }
+func (m *GetFullValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFullValidatorSetResponse) ProtoMessage()    {}
+func (*GetFullValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{12}
+}
+func (m *GetFullValidatorSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetSyncingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFullValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetSyncingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFullValidatorSetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -599,41 +747,51 @@ func (m *GetSyncingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetSyncingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSyncingResponse.Merge(m, src)
+func (m *GetFullValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFullValidatorSetResponse.Merge(m, src)
 }
-func (m *GetSyncingResponse) XXX_Size() int {
+func (m *GetFullValidatorSetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetSyncingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSyncingResponse.DiscardUnknown(m)
+func (m *GetFullValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFullValidatorSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSyncingResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetFullValidatorSetResponse proto.InternalMessageInfo
 
-func (m *GetSyncingResponse) GetSyncing() bool {
+func (m *GetFullValidatorSetResponse) GetBlockHeight() int64 {
 	if m != nil {
-		return m.Syncing
+		return m.BlockHeight
 	}
-	return false
+	return 0
 }
 
-// GetNodeInfoRequest is the request type for the Query/GetNodeInfo RPC method.
-type GetNodeInfoRequest struct {
+func (m *GetFullValidatorSetResponse) GetValidators() []*Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (m *GetNodeInfoRequest) Reset()         { *m = GetNodeInfoRequest{} }
-func (m *GetNodeInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetNodeInfoRequest) ProtoMessage()    {}
-func (*GetNodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{11}
+// StreamValidatorSetResponse is the response type for the Query/StreamValidatorSet RPC method.
+// Each message holds one page of the validator-set.
+type StreamValidatorSetResponse struct {
+	BlockHeight int64        `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Validators  []*Validator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
 }
-func (m *GetNodeInfoRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *StreamValidatorSetResponse) Reset()         { *m = StreamValidatorSetResponse{} }
+func (m *StreamValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamValidatorSetResponse) ProtoMessage()    {}
+func (*StreamValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{13}
+}
+func (m *StreamValidatorSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetNodeInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamValidatorSetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -643,36 +801,51 @@ func (m *GetNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetNodeInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNodeInfoRequest.Merge(m, src)
+func (m *StreamValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamValidatorSetResponse.Merge(m, src)
 }
-func (m *GetNodeInfoRequest) XXX_Size() int {
+func (m *StreamValidatorSetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetNodeInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNodeInfoRequest.DiscardUnknown(m)
+func (m *StreamValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamValidatorSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNodeInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_StreamValidatorSetResponse proto.InternalMessageInfo
 
-// GetNodeInfoResponse is the response type for the Query/GetNodeInfo RPC method.
-type GetNodeInfoResponse struct {
-	DefaultNodeInfo    *p2p.DefaultNodeInfo `protobuf:"bytes,1,opt,name=default_node_info,json=defaultNodeInfo,proto3" json:"default_node_info,omitempty"`
-	ApplicationVersion *VersionInfo         `protobuf:"bytes,2,opt,name=application_version,json=applicationVersion,proto3" json:"application_version,omitempty"`
+func (m *StreamValidatorSetResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *GetNodeInfoResponse) Reset()         { *m = GetNodeInfoResponse{} }
-func (m *GetNodeInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetNodeInfoResponse) ProtoMessage()    {}
-func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{12}
+func (m *StreamValidatorSetResponse) GetValidators() []*Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
-func (m *GetNodeInfoResponse) XXX_Unmarshal(b []byte) error {
+
+// GetValidatorSetDiffRequest is the request type for the Query/GetValidatorSetDiff RPC method.
+type GetValidatorSetDiffRequest struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the height to compare with. Zero means the latest height.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *GetValidatorSetDiffRequest) Reset()         { *m = GetValidatorSetDiffRequest{} }
+func (m *GetValidatorSetDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorSetDiffRequest) ProtoMessage()    {}
+func (*GetValidatorSetDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{14}
+}
+func (m *GetValidatorSetDiffRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetValidatorSetDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetNodeInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetValidatorSetDiffRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -682,57 +855,59 @@ func (m *GetNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetNodeInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNodeInfoResponse.Merge(m, src)
+func (m *GetValidatorSetDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorSetDiffRequest.Merge(m, src)
 }
-func (m *GetNodeInfoResponse) XXX_Size() int {
+func (m *GetValidatorSetDiffRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetNodeInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNodeInfoResponse.DiscardUnknown(m)
+func (m *GetValidatorSetDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorSetDiffRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNodeInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetValidatorSetDiffRequest proto.InternalMessageInfo
 
-func (m *GetNodeInfoResponse) GetDefaultNodeInfo() *p2p.DefaultNodeInfo {
+func (m *GetValidatorSetDiffRequest) GetFromHeight() int64 {
 	if m != nil {
-		return m.DefaultNodeInfo
+		return m.FromHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *GetNodeInfoResponse) GetApplicationVersion() *VersionInfo {
+func (m *GetValidatorSetDiffRequest) GetToHeight() int64 {
 	if m != nil {
-		return m.ApplicationVersion
+		return m.ToHeight
 	}
-	return nil
-}
-
-// VersionInfo is the type for the GetNodeInfoResponse message.
-type VersionInfo struct {
-	Name      string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AppName   string    `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Version   string    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	GitCommit string    `protobuf:"bytes,4,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
-	BuildTags string    `protobuf:"bytes,5,opt,name=build_tags,json=buildTags,proto3" json:"build_tags,omitempty"`
-	GoVersion string    `protobuf:"bytes,6,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
-	BuildDeps []*Module `protobuf:"bytes,7,rep,name=build_deps,json=buildDeps,proto3" json:"build_deps,omitempty"`
-	// Since: cosmos-sdk 0.43
-	CosmosSdkVersion string `protobuf:"bytes,8,opt,name=cosmos_sdk_version,json=cosmosSdkVersion,proto3" json:"cosmos_sdk_version,omitempty"`
+	return 0
 }
 
-func (m *VersionInfo) Reset()         { *m = VersionInfo{} }
-func (m *VersionInfo) String() string { return proto.CompactTextString(m) }
-func (*VersionInfo) ProtoMessage()    {}
-func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{13}
+// GetValidatorSetDiffResponse is the response type for the Query/GetValidatorSetDiff RPC method.
+// All lists are sorted by address.
+type GetValidatorSetDiffResponse struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the resolved height when the latest height was requested.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// added lists validators present at to_height only.
+	Added []*Validator `protobuf:"bytes,3,rep,name=added,proto3" json:"added,omitempty"`
+	// removed lists validators present at from_height only.
+	Removed []*Validator `protobuf:"bytes,4,rep,name=removed,proto3" json:"removed,omitempty"`
+	// changed lists validators present at both heights whose voting power or
+	// proposer priority differs.
+	Changed []*ValidatorChange `protobuf:"bytes,5,rep,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (m *GetValidatorSetDiffResponse) Reset()         { *m = GetValidatorSetDiffResponse{} }
+func (m *GetValidatorSetDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorSetDiffResponse) ProtoMessage()    {}
+func (*GetValidatorSetDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{15}
 }
-func (m *VersionInfo) XXX_Unmarshal(b []byte) error {
+func (m *GetValidatorSetDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VersionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetValidatorSetDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VersionInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetValidatorSetDiffResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -742,96 +917,76 @@ func (m *VersionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *VersionInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VersionInfo.Merge(m, src)
+func (m *GetValidatorSetDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorSetDiffResponse.Merge(m, src)
 }
-func (m *VersionInfo) XXX_Size() int {
+func (m *GetValidatorSetDiffResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *VersionInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_VersionInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_VersionInfo proto.InternalMessageInfo
-
-func (m *VersionInfo) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *VersionInfo) GetAppName() string {
-	if m != nil {
-		return m.AppName
-	}
-	return ""
+func (m *GetValidatorSetDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorSetDiffResponse.DiscardUnknown(m)
 }
 
-func (m *VersionInfo) GetVersion() string {
-	if m != nil {
-		return m.Version
-	}
-	return ""
-}
+var xxx_messageInfo_GetValidatorSetDiffResponse proto.InternalMessageInfo
 
-func (m *VersionInfo) GetGitCommit() string {
+func (m *GetValidatorSetDiffResponse) GetFromHeight() int64 {
 	if m != nil {
-		return m.GitCommit
+		return m.FromHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *VersionInfo) GetBuildTags() string {
+func (m *GetValidatorSetDiffResponse) GetToHeight() int64 {
 	if m != nil {
-		return m.BuildTags
+		return m.ToHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *VersionInfo) GetGoVersion() string {
+func (m *GetValidatorSetDiffResponse) GetAdded() []*Validator {
 	if m != nil {
-		return m.GoVersion
+		return m.Added
 	}
-	return ""
+	return nil
 }
 
-func (m *VersionInfo) GetBuildDeps() []*Module {
+func (m *GetValidatorSetDiffResponse) GetRemoved() []*Validator {
 	if m != nil {
-		return m.BuildDeps
+		return m.Removed
 	}
 	return nil
 }
 
-func (m *VersionInfo) GetCosmosSdkVersion() string {
+func (m *GetValidatorSetDiffResponse) GetChanged() []*ValidatorChange {
 	if m != nil {
-		return m.CosmosSdkVersion
+		return m.Changed
 	}
-	return ""
+	return nil
 }
 
-// Module is the type for VersionInfo
-type Module struct {
-	// module path
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// module version
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// checksum
-	Sum string `protobuf:"bytes,3,opt,name=sum,proto3" json:"sum,omitempty"`
+// ValidatorChange describes how a validator changed between two heights.
+type ValidatorChange struct {
+	Address               string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	FromVotingPower       int64  `protobuf:"varint,2,opt,name=from_voting_power,json=fromVotingPower,proto3" json:"from_voting_power,omitempty"`
+	ToVotingPower         int64  `protobuf:"varint,3,opt,name=to_voting_power,json=toVotingPower,proto3" json:"to_voting_power,omitempty"`
+	VotingPowerDelta      int64  `protobuf:"varint,4,opt,name=voting_power_delta,json=votingPowerDelta,proto3" json:"voting_power_delta,omitempty"`
+	FromProposerPriority  int64  `protobuf:"varint,5,opt,name=from_proposer_priority,json=fromProposerPriority,proto3" json:"from_proposer_priority,omitempty"`
+	ToProposerPriority    int64  `protobuf:"varint,6,opt,name=to_proposer_priority,json=toProposerPriority,proto3" json:"to_proposer_priority,omitempty"`
+	ProposerPriorityDelta int64  `protobuf:"varint,7,opt,name=proposer_priority_delta,json=proposerPriorityDelta,proto3" json:"proposer_priority_delta,omitempty"`
 }
 
-func (m *Module) Reset()         { *m = Module{} }
-func (m *Module) String() string { return proto.CompactTextString(m) }
-func (*Module) ProtoMessage()    {}
-func (*Module) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{14}
+func (m *ValidatorChange) Reset()         { *m = ValidatorChange{} }
+func (m *ValidatorChange) String() string { return proto.CompactTextString(m) }
+func (*ValidatorChange) ProtoMessage()    {}
+func (*ValidatorChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{16}
 }
-func (m *Module) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Module) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Module.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -841,136 +996,87 @@ func (m *Module) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Module) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Module.Merge(m, src)
+func (m *ValidatorChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorChange.Merge(m, src)
 }
-func (m *Module) XXX_Size() int {
+func (m *ValidatorChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *Module) XXX_DiscardUnknown() {
-	xxx_messageInfo_Module.DiscardUnknown(m)
+func (m *ValidatorChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Module proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorChange proto.InternalMessageInfo
 
-func (m *Module) GetPath() string {
+func (m *ValidatorChange) GetAddress() string {
 	if m != nil {
-		return m.Path
+		return m.Address
 	}
 	return ""
 }
 
-func (m *Module) GetVersion() string {
+func (m *ValidatorChange) GetFromVotingPower() int64 {
 	if m != nil {
-		return m.Version
+		return m.FromVotingPower
 	}
-	return ""
+	return 0
 }
 
-func (m *Module) GetSum() string {
+func (m *ValidatorChange) GetToVotingPower() int64 {
 	if m != nil {
-		return m.Sum
-	}
-	return ""
-}
-
-// ABCIQueryRequest defines the request structure for the ABCIQuery gRPC query.
-type ABCIQueryRequest struct {
-	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Prove  bool   `protobuf:"varint,4,opt,name=prove,proto3" json:"prove,omitempty"`
-}
-
-func (m *ABCIQueryRequest) Reset()         { *m = ABCIQueryRequest{} }
-func (m *ABCIQueryRequest) String() string { return proto.CompactTextString(m) }
-func (*ABCIQueryRequest) ProtoMessage()    {}
-func (*ABCIQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{15}
-}
-func (m *ABCIQueryRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ABCIQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ABCIQueryRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+		return m.ToVotingPower
 	}
+	return 0
 }
-func (m *ABCIQueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ABCIQueryRequest.Merge(m, src)
-}
-func (m *ABCIQueryRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *ABCIQueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ABCIQueryRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ABCIQueryRequest proto.InternalMessageInfo
 
-func (m *ABCIQueryRequest) GetData() []byte {
+func (m *ValidatorChange) GetVotingPowerDelta() int64 {
 	if m != nil {
-		return m.Data
+		return m.VotingPowerDelta
 	}
-	return nil
+	return 0
 }
 
-func (m *ABCIQueryRequest) GetPath() string {
+func (m *ValidatorChange) GetFromProposerPriority() int64 {
 	if m != nil {
-		return m.Path
+		return m.FromProposerPriority
 	}
-	return ""
+	return 0
 }
 
-func (m *ABCIQueryRequest) GetHeight() int64 {
+func (m *ValidatorChange) GetToProposerPriority() int64 {
 	if m != nil {
-		return m.Height
+		return m.ToProposerPriority
 	}
 	return 0
 }
 
-func (m *ABCIQueryRequest) GetProve() bool {
+func (m *ValidatorChange) GetProposerPriorityDelta() int64 {
 	if m != nil {
-		return m.Prove
+		return m.ProposerPriorityDelta
 	}
-	return false
+	return 0
 }
 
-// ABCIQueryResponse defines the response structure for the ABCIQuery gRPC query.
-//
-// Note: This type is a duplicate of the ResponseQuery proto type defined in
-// Tendermint.
-type ABCIQueryResponse struct {
-	Code      uint32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Log       string    `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
-	Info      string    `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
-	Index     int64     `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
-	Key       []byte    `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
-	Value     []byte    `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
-	ProofOps  *ProofOps `protobuf:"bytes,8,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty"`
-	Height    int64     `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
-	Codespace string    `protobuf:"bytes,10,opt,name=codespace,proto3" json:"codespace,omitempty"`
+// Validator is the type for the validator-set.
+type Validator struct {
+	Address          string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PubKey           *types1.Any `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	VotingPower      int64       `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	ProposerPriority int64       `protobuf:"varint,4,opt,name=proposer_priority,json=proposerPriority,proto3" json:"proposer_priority,omitempty"`
 }
 
-func (m *ABCIQueryResponse) Reset()         { *m = ABCIQueryResponse{} }
-func (m *ABCIQueryResponse) String() string { return proto.CompactTextString(m) }
-func (*ABCIQueryResponse) ProtoMessage()    {}
-func (*ABCIQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{16}
+func (m *Validator) Reset()         { *m = Validator{} }
+func (m *Validator) String() string { return proto.CompactTextString(m) }
+func (*Validator) ProtoMessage()    {}
+func (*Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{17}
 }
-func (m *ABCIQueryResponse) XXX_Unmarshal(b []byte) error {
+func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ABCIQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ABCIQueryResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -980,104 +1086,112 @@ func (m *ABCIQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ABCIQueryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ABCIQueryResponse.Merge(m, src)
+func (m *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(m, src)
 }
-func (m *ABCIQueryResponse) XXX_Size() int {
+func (m *Validator) XXX_Size() int {
 	return m.Size()
 }
-func (m *ABCIQueryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ABCIQueryResponse.DiscardUnknown(m)
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ABCIQueryResponse proto.InternalMessageInfo
+var xxx_messageInfo_Validator proto.InternalMessageInfo
 
-func (m *ABCIQueryResponse) GetCode() uint32 {
+func (m *Validator) GetAddress() string {
 	if m != nil {
-		return m.Code
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *ABCIQueryResponse) GetLog() string {
+func (m *Validator) GetPubKey() *types1.Any {
 	if m != nil {
-		return m.Log
+		return m.PubKey
 	}
-	return ""
+	return nil
 }
 
-func (m *ABCIQueryResponse) GetInfo() string {
+func (m *Validator) GetVotingPower() int64 {
 	if m != nil {
-		return m.Info
+		return m.VotingPower
 	}
-	return ""
+	return 0
 }
 
-func (m *ABCIQueryResponse) GetIndex() int64 {
+func (m *Validator) GetProposerPriority() int64 {
 	if m != nil {
-		return m.Index
+		return m.ProposerPriority
 	}
 	return 0
 }
 
-func (m *ABCIQueryResponse) GetKey() []byte {
-	if m != nil {
-		return m.Key
-	}
-	return nil
+// GetBlockByHeightRequest is the request type for the Query/GetBlockByHeight RPC method.
+type GetBlockByHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *ABCIQueryResponse) GetValue() []byte {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+func (m *GetBlockByHeightRequest) Reset()         { *m = GetBlockByHeightRequest{} }
+func (m *GetBlockByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByHeightRequest) ProtoMessage()    {}
+func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{18}
 }
-
-func (m *ABCIQueryResponse) GetProofOps() *ProofOps {
-	if m != nil {
-		return m.ProofOps
+func (m *GetBlockByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *GetBlockByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByHeightRequest.Merge(m, src)
+}
+func (m *GetBlockByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByHeightRequest.DiscardUnknown(m)
 }
 
-func (m *ABCIQueryResponse) GetHeight() int64 {
+var xxx_messageInfo_GetBlockByHeightRequest proto.InternalMessageInfo
+
+func (m *GetBlockByHeightRequest) GetHeight() int64 {
 	if m != nil {
 		return m.Height
 	}
 	return 0
 }
 
-func (m *ABCIQueryResponse) GetCodespace() string {
-	if m != nil {
-		return m.Codespace
-	}
-	return ""
-}
-
-// ProofOp defines an operation used for calculating Merkle root. The data could
-// be arbitrary format, providing necessary data for example neighbouring node
-// hash.
-//
-// Note: This type is a duplicate of the ProofOp proto type defined in Tendermint.
-type ProofOp struct {
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Key  []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+// GetBlockByHeightResponse is the response type for the Query/GetBlockByHeight RPC method.
+type GetBlockByHeightResponse struct {
+	BlockId *types.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Deprecated: please use `sdk_block` instead
+	Block *types.Block `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// Since: cosmos-sdk 0.47
+	SdkBlock *Block `protobuf:"bytes,3,opt,name=sdk_block,json=sdkBlock,proto3" json:"sdk_block,omitempty"`
 }
 
-func (m *ProofOp) Reset()         { *m = ProofOp{} }
-func (m *ProofOp) String() string { return proto.CompactTextString(m) }
-func (*ProofOp) ProtoMessage()    {}
-func (*ProofOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{17}
+func (m *GetBlockByHeightResponse) Reset()         { *m = GetBlockByHeightResponse{} }
+func (m *GetBlockByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockByHeightResponse) ProtoMessage()    {}
+func (*GetBlockByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{19}
 }
-func (m *ProofOp) XXX_Unmarshal(b []byte) error {
+func (m *GetBlockByHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProofOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBlockByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProofOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBlockByHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1087,58 +1201,56 @@ func (m *ProofOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ProofOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProofOp.Merge(m, src)
+func (m *GetBlockByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockByHeightResponse.Merge(m, src)
 }
-func (m *ProofOp) XXX_Size() int {
+func (m *GetBlockByHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProofOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProofOp.DiscardUnknown(m)
+func (m *GetBlockByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockByHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProofOp proto.InternalMessageInfo
+var xxx_messageInfo_GetBlockByHeightResponse proto.InternalMessageInfo
 
-func (m *ProofOp) GetType() string {
+func (m *GetBlockByHeightResponse) GetBlockId() *types.BlockID {
 	if m != nil {
-		return m.Type
+		return m.BlockId
 	}
-	return ""
+	return nil
 }
 
-func (m *ProofOp) GetKey() []byte {
+func (m *GetBlockByHeightResponse) GetBlock() *types.Block {
 	if m != nil {
-		return m.Key
+		return m.Block
 	}
 	return nil
 }
 
-func (m *ProofOp) GetData() []byte {
+func (m *GetBlockByHeightResponse) GetSdkBlock() *Block {
 	if m != nil {
-		return m.Data
+		return m.SdkBlock
 	}
 	return nil
 }
 
-// ProofOps is Merkle proof defined by the list of ProofOps.
-//
-// Note: This type is a duplicate of the ProofOps proto type defined in Tendermint.
-type ProofOps struct {
-	Ops []ProofOp `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops"`
+// GetDecodedBlockByHeightRequest is the request type for the Query/GetDecodedBlockByHeight RPC method.
+type GetDecodedBlockByHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *ProofOps) Reset()         { *m = ProofOps{} }
-func (m *ProofOps) String() string { return proto.CompactTextString(m) }
-func (*ProofOps) ProtoMessage()    {}
-func (*ProofOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6616aa04c2c794d7, []int{18}
+func (m *GetDecodedBlockByHeightRequest) Reset()         { *m = GetDecodedBlockByHeightRequest{} }
+func (m *GetDecodedBlockByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDecodedBlockByHeightRequest) ProtoMessage()    {}
+func (*GetDecodedBlockByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{20}
 }
-func (m *ProofOps) XXX_Unmarshal(b []byte) error {
+func (m *GetDecodedBlockByHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProofOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDecodedBlockByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProofOps.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDecodedBlockByHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1148,1729 +1260,6232 @@ func (m *ProofOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ProofOps) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProofOps.Merge(m, src)
+func (m *GetDecodedBlockByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDecodedBlockByHeightRequest.Merge(m, src)
 }
-func (m *ProofOps) XXX_Size() int {
+func (m *GetDecodedBlockByHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProofOps) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProofOps.DiscardUnknown(m)
+func (m *GetDecodedBlockByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDecodedBlockByHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProofOps proto.InternalMessageInfo
+var xxx_messageInfo_GetDecodedBlockByHeightRequest proto.InternalMessageInfo
 
-func (m *ProofOps) GetOps() []ProofOp {
+func (m *GetDecodedBlockByHeightRequest) GetHeight() int64 {
 	if m != nil {
-		return m.Ops
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*GetValidatorSetByHeightRequest)(nil), "api.cosmos.forwarder.v1.GetValidatorSetByHeightRequest")
-	proto.RegisterType((*GetValidatorSetByHeightResponse)(nil), "api.cosmos.forwarder.v1.GetValidatorSetByHeightResponse")
-	proto.RegisterType((*GetLatestValidatorSetRequest)(nil), "api.cosmos.forwarder.v1.GetLatestValidatorSetRequest")
-	proto.RegisterType((*GetLatestValidatorSetResponse)(nil), "api.cosmos.forwarder.v1.GetLatestValidatorSetResponse")
-	proto.RegisterType((*Validator)(nil), "api.cosmos.forwarder.v1.Validator")
-	proto.RegisterType((*GetBlockByHeightRequest)(nil), "api.cosmos.forwarder.v1.GetBlockByHeightRequest")
-	proto.RegisterType((*GetBlockByHeightResponse)(nil), "api.cosmos.forwarder.v1.GetBlockByHeightResponse")
-	proto.RegisterType((*GetLatestBlockRequest)(nil), "api.cosmos.forwarder.v1.GetLatestBlockRequest")
-	proto.RegisterType((*GetLatestBlockResponse)(nil), "api.cosmos.forwarder.v1.GetLatestBlockResponse")
-	proto.RegisterType((*GetSyncingRequest)(nil), "api.cosmos.forwarder.v1.GetSyncingRequest")
-	proto.RegisterType((*GetSyncingResponse)(nil), "api.cosmos.forwarder.v1.GetSyncingResponse")
-	proto.RegisterType((*GetNodeInfoRequest)(nil), "api.cosmos.forwarder.v1.GetNodeInfoRequest")
-	proto.RegisterType((*GetNodeInfoResponse)(nil), "api.cosmos.forwarder.v1.GetNodeInfoResponse")
-	proto.RegisterType((*VersionInfo)(nil), "api.cosmos.forwarder.v1.VersionInfo")
-	proto.RegisterType((*Module)(nil), "api.cosmos.forwarder.v1.Module")
-	proto.RegisterType((*ABCIQueryRequest)(nil), "api.cosmos.forwarder.v1.ABCIQueryRequest")
-	proto.RegisterType((*ABCIQueryResponse)(nil), "api.cosmos.forwarder.v1.ABCIQueryResponse")
-	proto.RegisterType((*ProofOp)(nil), "api.cosmos.forwarder.v1.ProofOp")
-	proto.RegisterType((*ProofOps)(nil), "api.cosmos.forwarder.v1.ProofOps")
+// GetDecodedBlockByHeightResponse is the response type for the Query/GetDecodedBlockByHeight RPC method.
+type GetDecodedBlockByHeightResponse struct {
+	BlockId  *types.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	SdkBlock *Block         `protobuf:"bytes,2,opt,name=sdk_block,json=sdkBlock,proto3" json:"sdk_block,omitempty"`
+	// txs holds the decoded transactions in the order of the block data.
+	Txs []*DecodedTx `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("api/cosmos/forwarder/v1/query.proto", fileDescriptor_6616aa04c2c794d7)
+func (m *GetDecodedBlockByHeightResponse) Reset()         { *m = GetDecodedBlockByHeightResponse{} }
+func (m *GetDecodedBlockByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDecodedBlockByHeightResponse) ProtoMessage()    {}
+func (*GetDecodedBlockByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{21}
 }
-
-var fileDescriptor_6616aa04c2c794d7 = []byte{
-	// 1415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x69, 0x6c, 0x3f, 0xf7, 0xfb, 0x25, 0x99, 0x86, 0xc6, 0xb1, 0x52, 0x27, 0x5d,
-	0x10, 0x6d, 0x93, 0x66, 0x17, 0xbb, 0x3f, 0x25, 0xa0, 0x52, 0xdd, 0x8a, 0x34, 0x94, 0x96, 0xb0,
-	0x01, 0x0e, 0x5c, 0x56, 0x63, 0xef, 0x78, 0xb3, 0x8a, 0xbd, 0x33, 0xdd, 0x1d, 0xbb, 0x58, 0x08,
-	0x09, 0x71, 0xe1, 0x8a, 0x84, 0xd4, 0x0b, 0x27, 0x6e, 0x3d, 0xf6, 0xc0, 0x05, 0x84, 0x38, 0x71,
-	0xe8, 0x01, 0x44, 0x81, 0x0b, 0x27, 0x84, 0x5a, 0x24, 0xfe, 0x0d, 0x34, 0x3f, 0xd6, 0x5e, 0xb7,
-	0x71, 0x9c, 0x70, 0xeb, 0x25, 0x99, 0x79, 0x3f, 0x3f, 0x9f, 0xf7, 0x66, 0xde, 0x8e, 0xe1, 0x25,
-	0xcc, 0x02, 0xbb, 0x49, 0xe3, 0x0e, 0x8d, 0xed, 0x16, 0x8d, 0xee, 0xe2, 0xc8, 0x23, 0x91, 0xdd,
-	0xab, 0xda, 0x77, 0xba, 0x24, 0xea, 0x5b, 0x2c, 0xa2, 0x9c, 0xa2, 0x05, 0xcc, 0x02, 0x4b, 0x19,
-	0x59, 0x03, 0x23, 0xab, 0x57, 0x2d, 0xcf, 0xfb, 0xd4, 0xa7, 0xd2, 0xc6, 0x16, 0x2b, 0x65, 0x5e,
-	0x5e, 0xf4, 0x29, 0xf5, 0xdb, 0xc4, 0x96, 0xbb, 0x46, 0xb7, 0x65, 0xe3, 0x50, 0x47, 0x2a, 0x2f,
-	0x69, 0x95, 0xc8, 0x8a, 0xc3, 0x90, 0x72, 0xcc, 0x03, 0x1a, 0xc6, 0x5a, 0x5b, 0xe6, 0x24, 0xf4,
-	0x48, 0xd4, 0x09, 0x42, 0x6e, 0xb3, 0x1a, 0xb3, 0x79, 0x9f, 0x91, 0x44, 0xb7, 0x94, 0xd2, 0x49,
-	0xf9, 0x88, 0x76, 0x55, 0x53, 0x68, 0xe0, 0x98, 0x28, 0xe8, 0x76, 0xaf, 0xda, 0x20, 0x1c, 0x57,
-	0x6d, 0x86, 0xfd, 0x20, 0x94, 0x69, 0xf6, 0xb2, 0x4d, 0x45, 0x4d, 0x1c, 0xd2, 0x71, 0x17, 0x95,
-	0xad, 0xab, 0x38, 0xea, 0x12, 0x8c, 0x03, 0xd4, 0x68, 0xd3, 0xe6, 0xae, 0xd6, 0xce, 0xe1, 0x4e,
-	0x10, 0x52, 0x5b, 0xfe, 0xd5, 0xa2, 0xb1, 0xa5, 0x4e, 0x25, 0x34, 0x3f, 0x35, 0xa0, 0xb2, 0x41,
-	0xf8, 0x07, 0xb8, 0x1d, 0x78, 0x98, 0xd3, 0x68, 0x9b, 0xf0, 0x7a, 0xff, 0x06, 0x09, 0xfc, 0x1d,
-	0xee, 0x90, 0x3b, 0x5d, 0x12, 0x73, 0x74, 0x1c, 0x66, 0x76, 0xa4, 0xa0, 0x64, 0xac, 0x18, 0xa7,
-	0xb3, 0x8e, 0xde, 0xa1, 0x37, 0x01, 0x86, 0x5c, 0x4b, 0x99, 0x15, 0xe3, 0x74, 0xb1, 0xf6, 0x4a,
-	0xd2, 0x36, 0x41, 0xd6, 0x52, 0x3d, 0xd5, 0x3c, 0xad, 0x2d, 0xec, 0x13, 0x1d, 0xd3, 0x49, 0x79,
-	0x9a, 0xbf, 0x1a, 0xb0, 0x3c, 0x16, 0x42, 0xcc, 0x68, 0x18, 0x13, 0x74, 0x12, 0x8e, 0x4a, 0xb6,
-	0xee, 0x08, 0x92, 0xa2, 0x94, 0x29, 0x53, 0x54, 0x07, 0xe8, 0x25, 0x21, 0xe2, 0x52, 0x66, 0x25,
-	0x7b, 0xba, 0x58, 0x33, 0xad, 0x31, 0x27, 0xc9, 0x1a, 0x64, 0x73, 0x52, 0x5e, 0x68, 0x63, 0x84,
-	0x52, 0x56, 0x52, 0x3a, 0x35, 0x91, 0x92, 0xc2, 0x38, 0xc2, 0xa9, 0x05, 0x4b, 0x1b, 0x84, 0xbf,
-	0x8d, 0x39, 0x89, 0x47, 0x88, 0x25, 0x35, 0x1d, 0xad, 0x9d, 0xf1, 0x9f, 0x6b, 0xf7, 0x8b, 0x01,
-	0x27, 0xc6, 0x24, 0x7a, 0x4e, 0x2b, 0xf7, 0x83, 0x01, 0x85, 0x41, 0x0a, 0x54, 0x83, 0x1c, 0xf6,
-	0xbc, 0x88, 0xc4, 0xb1, 0x04, 0x5e, 0xa8, 0x97, 0x7e, 0xfb, 0x66, 0x7d, 0x5e, 0x87, 0xbd, 0xaa,
-	0x34, 0xdb, 0x3c, 0x0a, 0x42, 0xdf, 0x49, 0x0c, 0xd1, 0x3a, 0xe4, 0x58, 0xb7, 0xe1, 0xee, 0x92,
-	0xbe, 0x3e, 0x94, 0xf3, 0x96, 0x9a, 0x02, 0x56, 0x32, 0x20, 0xac, 0xab, 0x61, 0xdf, 0x99, 0x61,
-	0xdd, 0xc6, 0x4d, 0xd2, 0x17, 0x05, 0xea, 0x51, 0x1e, 0x84, 0xbe, 0xcb, 0xe8, 0x5d, 0x12, 0x49,
-	0xec, 0x59, 0xa7, 0xa8, 0x64, 0x5b, 0x42, 0x84, 0xd6, 0x60, 0x8e, 0x45, 0x94, 0xd1, 0x98, 0x44,
-	0x2e, 0x8b, 0x02, 0x1a, 0x05, 0xbc, 0x5f, 0x9a, 0x96, 0x76, 0xb3, 0x89, 0x62, 0x4b, 0xcb, 0xcd,
-	0x2a, 0x2c, 0x6c, 0x10, 0x5e, 0x17, 0xf5, 0x3d, 0xe0, 0x4d, 0x32, 0xbf, 0x37, 0xa0, 0xf4, 0xac,
-	0x8f, 0x6e, 0xe0, 0x79, 0xc8, 0xab, 0x06, 0x06, 0x9e, 0x3e, 0x28, 0x8b, 0xd6, 0x70, 0x14, 0x58,
-	0xea, 0x32, 0x4b, 0xd7, 0xcd, 0xeb, 0x4e, 0x4e, 0x9a, 0x6e, 0x7a, 0x68, 0x1d, 0x8e, 0xc8, 0xa5,
-	0x2e, 0xc1, 0xc2, 0x18, 0x17, 0x47, 0x59, 0xa1, 0xd7, 0xa0, 0x10, 0x7b, 0xbb, 0xae, 0x72, 0x51,
-	0xdd, 0xab, 0x8c, 0x3d, 0x01, 0xca, 0x33, 0x1f, 0x7b, 0xbb, 0x72, 0x65, 0x2e, 0xc0, 0x8b, 0x83,
-	0x33, 0xa8, 0x74, 0x8a, 0xaf, 0xf9, 0x9d, 0x01, 0xc7, 0x9f, 0xd6, 0x3c, 0x37, 0xac, 0x8e, 0xc1,
-	0xdc, 0x06, 0xe1, 0xdb, 0xfd, 0xb0, 0x29, 0x4e, 0x97, 0x66, 0x64, 0x01, 0x4a, 0x0b, 0x35, 0x99,
-	0x12, 0xe4, 0x62, 0x25, 0x92, 0x5c, 0xf2, 0x4e, 0xb2, 0x35, 0xe7, 0xa5, 0xfd, 0x6d, 0xea, 0x91,
-	0xcd, 0xb0, 0x45, 0x93, 0x28, 0xdf, 0x1a, 0x70, 0x6c, 0x44, 0xac, 0xe3, 0xdc, 0x84, 0x39, 0x8f,
-	0xb4, 0x70, 0xb7, 0xcd, 0xdd, 0x90, 0x7a, 0xc4, 0x0d, 0xc2, 0x16, 0xd5, 0xd5, 0x59, 0x4e, 0x53,
-	0x65, 0x35, 0x66, 0x5d, 0x57, 0x86, 0x83, 0x18, 0x2f, 0x78, 0xa3, 0x02, 0xf4, 0x3e, 0x1c, 0xc3,
-	0x8c, 0xb5, 0x83, 0xa6, 0xbc, 0x57, 0x6e, 0x8f, 0x44, 0xf1, 0x70, 0x4e, 0xbf, 0x3c, 0xfe, 0x7a,
-	0x2b, 0x3b, 0x19, 0x13, 0xa5, 0x02, 0x68, 0xb9, 0xf9, 0x75, 0x06, 0x8a, 0x29, 0x1b, 0x84, 0x60,
-	0x3a, 0xc4, 0x1d, 0xa2, 0xae, 0xa7, 0x23, 0xd7, 0x68, 0x11, 0xf2, 0x98, 0x31, 0x57, 0xca, 0x33,
-	0x52, 0x9e, 0xc3, 0x8c, 0xdd, 0x16, 0xaa, 0x12, 0xe4, 0x12, 0x24, 0x59, 0xa5, 0xd1, 0x5b, 0x74,
-	0x02, 0xc0, 0x0f, 0xb8, 0xdb, 0xa4, 0x9d, 0x4e, 0xc0, 0xe5, 0xed, 0x2a, 0x38, 0x05, 0x3f, 0xe0,
-	0xd7, 0xa4, 0x40, 0xa8, 0x1b, 0xdd, 0xa0, 0xed, 0xb9, 0x1c, 0xfb, 0x71, 0xe9, 0x88, 0x52, 0x4b,
-	0xc9, 0x7b, 0xd8, 0x8f, 0xa5, 0x37, 0x1d, 0x90, 0x9c, 0xd1, 0xde, 0x54, 0x23, 0x45, 0x57, 0x12,
-	0x6f, 0x8f, 0xb0, 0xb8, 0x94, 0x93, 0x23, 0x6e, 0x79, 0x6c, 0x0d, 0x6e, 0x51, 0xaf, 0xdb, 0x26,
-	0x3a, 0xfc, 0x75, 0xc2, 0x62, 0x74, 0x16, 0x90, 0xfe, 0x32, 0x8b, 0x03, 0x95, 0xa4, 0xc9, 0xcb,
-	0x34, 0xb3, 0x4a, 0xb3, 0xed, 0xed, 0x26, 0x35, 0xba, 0x01, 0x33, 0x2a, 0x84, 0xa8, 0x0e, 0xc3,
-	0x7c, 0x27, 0xa9, 0x8e, 0x58, 0xa7, 0x4b, 0x90, 0x19, 0x2d, 0xc1, 0x2c, 0x64, 0xe3, 0x6e, 0x47,
-	0x17, 0x46, 0x2c, 0xcd, 0x1d, 0x98, 0xbd, 0x5a, 0xbf, 0xb6, 0xf9, 0xae, 0x98, 0x9d, 0xc9, 0x14,
-	0x41, 0x30, 0xed, 0x61, 0x8e, 0x65, 0xcc, 0xa3, 0x8e, 0x5c, 0x0f, 0xf2, 0x64, 0x52, 0x79, 0x86,
-	0xd3, 0x26, 0x3b, 0xf2, 0xdd, 0x9e, 0x87, 0x23, 0x2c, 0xa2, 0x3d, 0x22, 0x6b, 0x9c, 0x77, 0xd4,
-	0xc6, 0xfc, 0x3c, 0x03, 0x73, 0xa9, 0x54, 0xfa, 0x44, 0x22, 0x98, 0x6e, 0x52, 0x4f, 0x75, 0xf7,
-	0x7f, 0x8e, 0x5c, 0x0b, 0x94, 0x6d, 0xea, 0x27, 0x28, 0xdb, 0xd4, 0x17, 0x56, 0xf2, 0xa8, 0xaa,
-	0xa6, 0xc9, 0xb5, 0xc8, 0x12, 0x84, 0x1e, 0xf9, 0x48, 0xb6, 0x2a, 0xeb, 0xa8, 0x8d, 0xf0, 0x15,
-	0x73, 0x79, 0x46, 0x42, 0x17, 0x4b, 0x61, 0xd7, 0xc3, 0xed, 0x2e, 0x29, 0xe5, 0xa4, 0x4c, 0x6d,
-	0xd0, 0x15, 0x28, 0xb0, 0x88, 0xd2, 0x96, 0x4b, 0x59, 0x2c, 0xcb, 0x5c, 0xac, 0x9d, 0x1c, 0xdb,
-	0xae, 0x2d, 0x61, 0xf9, 0x0e, 0x8b, 0x9d, 0x3c, 0xd3, 0xab, 0x14, 0xf7, 0xc2, 0x08, 0xf7, 0x25,
-	0x28, 0x08, 0x0e, 0x31, 0xc3, 0x4d, 0x52, 0x02, 0x75, 0x4a, 0x06, 0x82, 0xb7, 0xa6, 0xf3, 0x99,
-	0xd9, 0xac, 0x79, 0x0d, 0x72, 0x3a, 0xa2, 0x20, 0x26, 0xc6, 0x4a, 0xd2, 0x3e, 0xb1, 0x4e, 0x28,
-	0x64, 0x86, 0x14, 0x92, 0x86, 0x64, 0x87, 0x0d, 0x31, 0x37, 0x21, 0x9f, 0xc0, 0x42, 0x6f, 0x40,
-	0x56, 0xd0, 0x30, 0xe4, 0xa9, 0x5b, 0x99, 0x44, 0xa3, 0x5e, 0x78, 0xf8, 0xe7, 0xf2, 0xd4, 0xfd,
-	0x7f, 0x1e, 0xac, 0x1a, 0x8e, 0xf0, 0xab, 0xfd, 0x5c, 0x80, 0xdc, 0x36, 0x89, 0x7a, 0x41, 0x93,
-	0xa0, 0xaf, 0x0c, 0x28, 0xa6, 0x26, 0x07, 0x5a, 0x1b, 0x1b, 0xed, 0xd9, 0xb1, 0x53, 0x3e, 0x7b,
-	0x30, 0x63, 0xd5, 0x7a, 0xb3, 0xfa, 0xd9, 0xef, 0x7f, 0x7f, 0x99, 0x59, 0x43, 0x67, 0xec, 0x09,
-	0xef, 0xd7, 0xc1, 0xa8, 0x42, 0xf7, 0x0c, 0x80, 0xe1, 0x78, 0x44, 0xab, 0xfb, 0xe5, 0x1b, 0x1d,
-	0xac, 0xe5, 0xb5, 0x03, 0xd9, 0x6a, 0x68, 0xb6, 0x84, 0x76, 0x06, 0x9d, 0x9a, 0x04, 0x4d, 0x8f,
-	0x61, 0x74, 0xdf, 0x80, 0xff, 0x8f, 0x7e, 0x88, 0x90, 0xb5, 0x5f, 0xc2, 0x67, 0xbf, 0x65, 0x65,
-	0xfb, 0xc0, 0xf6, 0x1a, 0xe4, 0x05, 0x09, 0xd2, 0x46, 0xeb, 0x93, 0x40, 0xca, 0xcf, 0x53, 0x6c,
-	0xb7, 0x65, 0x0c, 0xf4, 0xc0, 0x80, 0xd9, 0xa7, 0xdf, 0x02, 0xe8, 0xd5, 0xfd, 0x92, 0xef, 0xf5,
-	0xd4, 0x28, 0x57, 0x0f, 0xe1, 0xa1, 0x01, 0x5f, 0x92, 0x80, 0xab, 0xc8, 0x3e, 0x20, 0xe0, 0x8f,
-	0xd5, 0x9d, 0xfa, 0x04, 0xfd, 0x68, 0xa4, 0x1e, 0x00, 0xe9, 0x47, 0x28, 0xba, 0x30, 0xb9, 0x68,
-	0x7b, 0xbc, 0x8e, 0xcb, 0x17, 0x0f, 0xeb, 0xa6, 0x19, 0xbc, 0x2e, 0x19, 0x5c, 0x44, 0xe7, 0x27,
-	0x31, 0x18, 0x3e, 0x5c, 0x09, 0x1f, 0x54, 0xfe, 0x27, 0x43, 0xbe, 0xdc, 0xf6, 0xfa, 0x1d, 0x82,
-	0x2e, 0xed, 0x87, 0x68, 0x9f, 0x1f, 0x4f, 0xe5, 0xcb, 0x87, 0x77, 0xd4, 0x64, 0xae, 0x48, 0x32,
-	0x97, 0xd1, 0xc5, 0xc3, 0x91, 0x19, 0x74, 0xe5, 0x9e, 0x01, 0x85, 0xc1, 0x40, 0x47, 0x67, 0xc6,
-	0xe2, 0x78, 0xfa, 0xfb, 0x52, 0x5e, 0x3d, 0x88, 0xa9, 0x06, 0x59, 0x93, 0x20, 0xcf, 0xa2, 0xd5,
-	0x49, 0x20, 0x71, 0xa3, 0x19, 0xb8, 0xf2, 0x27, 0x40, 0xfd, 0xd6, 0xc3, 0xc7, 0x15, 0xe3, 0xd1,
-	0xe3, 0x8a, 0xf1, 0xd7, 0xe3, 0x8a, 0xf1, 0xc5, 0x93, 0xca, 0xd4, 0xa3, 0x27, 0x95, 0xa9, 0x3f,
-	0x9e, 0x54, 0xa6, 0x3e, 0x3c, 0xe7, 0x07, 0x7c, 0xa7, 0xdb, 0xb0, 0x9a, 0xb4, 0x93, 0xc4, 0x53,
-	0xff, 0xd6, 0x63, 0x6f, 0xd7, 0x6e, 0xb6, 0x03, 0x12, 0x72, 0xdb, 0x8f, 0x58, 0xd3, 0x6e, 0x76,
-	0x78, 0xac, 0x46, 0x62, 0x63, 0x46, 0xbe, 0xea, 0xcf, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xf7,
-	0xec, 0x7f, 0x4b, 0x5a, 0x10, 0x00, 0x00,
+func (m *GetDecodedBlockByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ServiceClient is the client API for Service service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ServiceClient interface {
-	// GetNodeInfo queries the current node info.
-	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error)
-	// GetSyncing queries node syncing.
-	GetSyncing(ctx context.Context, in *GetSyncingRequest, opts ...grpc.CallOption) (*GetSyncingResponse, error)
-	// GetLatestBlock returns the latest block.
-	GetLatestBlock(ctx context.Context, in *GetLatestBlockRequest, opts ...grpc.CallOption) (*GetLatestBlockResponse, error)
-	// GetBlockByHeight queries block for given height.
-	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockByHeightResponse, error)
-	// GetLatestValidatorSet queries latest validator-set.
-	GetLatestValidatorSet(ctx context.Context, in *GetLatestValidatorSetRequest, opts ...grpc.CallOption) (*GetLatestValidatorSetResponse, error)
-	// GetValidatorSetByHeight queries validator-set at a given height.
-	GetValidatorSetByHeight(ctx context.Context, in *GetValidatorSetByHeightRequest, opts ...grpc.CallOption) (*GetValidatorSetByHeightResponse, error)
-	// ABCIQuery defines a query handler that supports ABCI queries directly to the
-	// application, bypassing Tendermint completely. The ABCI query must contain
-	// a valid and supported path, including app, custom, p2p, and store.
-	//
-	// Since: cosmos-sdk 0.46
-	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+func (m *GetDecodedBlockByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDecodedBlockByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type serviceClient struct {
-	cc grpc1.ClientConn
+func (m *GetDecodedBlockByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDecodedBlockByHeightResponse.Merge(m, src)
 }
-
-func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
-	return &serviceClient{cc}
+func (m *GetDecodedBlockByHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *serviceClient) GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error) {
-	out := new(GetNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetNodeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetDecodedBlockByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDecodedBlockByHeightResponse.DiscardUnknown(m)
 }
 
-func (c *serviceClient) GetSyncing(ctx context.Context, in *GetSyncingRequest, opts ...grpc.CallOption) (*GetSyncingResponse, error) {
-	out := new(GetSyncingResponse)
-	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetSyncing", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDecodedBlockByHeightResponse proto.InternalMessageInfo
+
+func (m *GetDecodedBlockByHeightResponse) GetBlockId() *types.BlockID {
+	if m != nil {
+		return m.BlockId
 	}
-	return out, nil
+	return nil
 }
 
-func (c *serviceClient) GetLatestBlock(ctx context.Context, in *GetLatestBlockRequest, opts ...grpc.CallOption) (*GetLatestBlockResponse, error) {
-	out := new(GetLatestBlockResponse)
-	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetLatestBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetDecodedBlockByHeightResponse) GetSdkBlock() *Block {
+	if m != nil {
+		return m.SdkBlock
 	}
-	return out, nil
+	return nil
 }
 
-func (c *serviceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockByHeightResponse, error) {
-	out := new(GetBlockByHeightResponse)
-	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetBlockByHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetDecodedBlockByHeightResponse) GetTxs() []*DecodedTx {
+	if m != nil {
+		return m.Txs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *serviceClient) GetLatestValidatorSet(ctx context.Context, in *GetLatestValidatorSetRequest, opts ...grpc.CallOption) (*GetLatestValidatorSetResponse, error) {
-	out := new(GetLatestValidatorSetResponse)
-	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetLatestValidatorSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+// DecodedTx is a transaction decoded from its raw block bytes.
+type DecodedTx struct {
+	// hash is the upper-case hex encoded SHA-256 hash of the raw transaction bytes.
+	Hash          string           `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Messages      []*types1.Any    `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Memo          string           `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	TimeoutHeight uint64           `protobuf:"varint,4,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	Fee           *tx.Fee          `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	SignerInfos   []*tx.SignerInfo `protobuf:"bytes,6,rep,name=signer_infos,json=signerInfos,proto3" json:"signer_infos,omitempty"`
+	Signatures    [][]byte         `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// decode_error holds the reason why the transaction could not be decoded.
+	// Only hash is set in that case, unless the transaction is well-formed and just
+	// holds messages or public keys of unknown types, which are kept as raw Any values.
+	DecodeError string `protobuf:"bytes,8,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+}
+
+func (m *DecodedTx) Reset()         { *m = DecodedTx{} }
+func (m *DecodedTx) String() string { return proto.CompactTextString(m) }
+func (*DecodedTx) ProtoMessage()    {}
+func (*DecodedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{22}
+}
+func (m *DecodedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DecodedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedTx.Merge(m, src)
+}
+func (m *DecodedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedTx.DiscardUnknown(m)
 }
 
-func (c *serviceClient) GetValidatorSetByHeight(ctx context.Context, in *GetValidatorSetByHeightRequest, opts ...grpc.CallOption) (*GetValidatorSetByHeightResponse, error) {
-	out := new(GetValidatorSetByHeightResponse)
-	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetValidatorSetByHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DecodedTx proto.InternalMessageInfo
+
+func (m *DecodedTx) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error) {
-	out := new(ABCIQueryResponse)
-	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/ABCIQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DecodedTx) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
 	}
-	return out, nil
+	return nil
 }
 
-// ServiceServer is the server API for Service service.
-type ServiceServer interface {
-	// GetNodeInfo queries the current node info.
-	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error)
-	// GetSyncing queries node syncing.
-	GetSyncing(context.Context, *GetSyncingRequest) (*GetSyncingResponse, error)
-	// GetLatestBlock returns the latest block.
-	GetLatestBlock(context.Context, *GetLatestBlockRequest) (*GetLatestBlockResponse, error)
-	// GetBlockByHeight queries block for given height.
-	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockByHeightResponse, error)
-	// GetLatestValidatorSet queries latest validator-set.
-	GetLatestValidatorSet(context.Context, *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error)
-	// GetValidatorSetByHeight queries validator-set at a given height.
-	GetValidatorSetByHeight(context.Context, *GetValidatorSetByHeightRequest) (*GetValidatorSetByHeightResponse, error)
-	// ABCIQuery defines a query handler that supports ABCI queries directly to the
-	// application, bypassing Tendermint completely. The ABCI query must contain
-	// a valid and supported path, including app, custom, p2p, and store.
-	//
-	// Since: cosmos-sdk 0.46
-	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+func (m *DecodedTx) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
 
-// UnimplementedServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedServiceServer struct {
+func (m *DecodedTx) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
 }
 
-func (*UnimplementedServiceServer) GetNodeInfo(ctx context.Context, req *GetNodeInfoRequest) (*GetNodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+func (m *DecodedTx) GetFee() *tx.Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
 }
-func (*UnimplementedServiceServer) GetSyncing(ctx context.Context, req *GetSyncingRequest) (*GetSyncingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSyncing not implemented")
+
+func (m *DecodedTx) GetSignerInfos() []*tx.SignerInfo {
+	if m != nil {
+		return m.SignerInfos
+	}
+	return nil
 }
-func (*UnimplementedServiceServer) GetLatestBlock(ctx context.Context, req *GetLatestBlockRequest) (*GetLatestBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestBlock not implemented")
+
+func (m *DecodedTx) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
 }
-func (*UnimplementedServiceServer) GetBlockByHeight(ctx context.Context, req *GetBlockByHeightRequest) (*GetBlockByHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
+
+func (m *DecodedTx) GetDecodeError() string {
+	if m != nil {
+		return m.DecodeError
+	}
+	return ""
 }
-func (*UnimplementedServiceServer) GetLatestValidatorSet(ctx context.Context, req *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestValidatorSet not implemented")
+
+// GetLatestBlockRequest is the request type for the Query/GetLatestBlock RPC method.
+type GetLatestBlockRequest struct {
 }
-func (*UnimplementedServiceServer) GetValidatorSetByHeight(ctx context.Context, req *GetValidatorSetByHeightRequest) (*GetValidatorSetByHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetByHeight not implemented")
+
+func (m *GetLatestBlockRequest) Reset()         { *m = GetLatestBlockRequest{} }
+func (m *GetLatestBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLatestBlockRequest) ProtoMessage()    {}
+func (*GetLatestBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{23}
 }
-func (*UnimplementedServiceServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABCIQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
+func (m *GetLatestBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatestBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatestBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLatestBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatestBlockRequest.Merge(m, src)
+}
+func (m *GetLatestBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatestBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatestBlockRequest.DiscardUnknown(m)
 }
 
-func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
-	s.RegisterService(&_Service_serviceDesc, srv)
+var xxx_messageInfo_GetLatestBlockRequest proto.InternalMessageInfo
+
+// GetLatestBlockResponse is the response type for the Query/GetLatestBlock RPC method.
+type GetLatestBlockResponse struct {
+	BlockId *types.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Deprecated: please use `sdk_block` instead
+	Block *types.Block `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// Since: cosmos-sdk 0.47
+	SdkBlock *Block `protobuf:"bytes,3,opt,name=sdk_block,json=sdkBlock,proto3" json:"sdk_block,omitempty"`
 }
 
-func _Service_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetNodeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.cosmos.forwarder.v1.Service/GetNodeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetNodeInfo(ctx, req.(*GetNodeInfoRequest))
+func (m *GetLatestBlockResponse) Reset()         { *m = GetLatestBlockResponse{} }
+func (m *GetLatestBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLatestBlockResponse) ProtoMessage()    {}
+func (*GetLatestBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{24}
+}
+func (m *GetLatestBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatestBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatestBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetLatestBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatestBlockResponse.Merge(m, src)
+}
+func (m *GetLatestBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatestBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatestBlockResponse.DiscardUnknown(m)
 }
 
-func _Service_GetSyncing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSyncingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetSyncing(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.cosmos.forwarder.v1.Service/GetSyncing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetSyncing(ctx, req.(*GetSyncingRequest))
+var xxx_messageInfo_GetLatestBlockResponse proto.InternalMessageInfo
+
+func (m *GetLatestBlockResponse) GetBlockId() *types.BlockID {
+	if m != nil {
+		return m.BlockId
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Service_GetLatestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetLatestBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.cosmos.forwarder.v1.Service/GetLatestBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetLatestBlock(ctx, req.(*GetLatestBlockRequest))
+func (m *GetLatestBlockResponse) GetBlock() *types.Block {
+	if m != nil {
+		return m.Block
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Service_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetBlockByHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.cosmos.forwarder.v1.Service/GetBlockByHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+func (m *GetLatestBlockResponse) GetSdkBlock() *Block {
+	if m != nil {
+		return m.SdkBlock
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Service_GetLatestValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestValidatorSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetLatestValidatorSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.cosmos.forwarder.v1.Service/GetLatestValidatorSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetLatestValidatorSet(ctx, req.(*GetLatestValidatorSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// GetSyncingRequest is the request type for the Query/GetSyncing RPC method.
+type GetSyncingRequest struct {
 }
 
-func _Service_GetValidatorSetByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorSetByHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).GetValidatorSetByHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.cosmos.forwarder.v1.Service/GetValidatorSetByHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).GetValidatorSetByHeight(ctx, req.(*GetValidatorSetByHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *GetSyncingRequest) Reset()         { *m = GetSyncingRequest{} }
+func (m *GetSyncingRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSyncingRequest) ProtoMessage()    {}
+func (*GetSyncingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{25}
 }
-
-func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ABCIQueryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ServiceServer).ABCIQuery(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.cosmos.forwarder.v1.Service/ABCIQuery",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ABCIQuery(ctx, req.(*ABCIQueryRequest))
+func (m *GetSyncingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSyncingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSyncingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Service_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.cosmos.forwarder.v1.Service",
-	HandlerType: (*ServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetNodeInfo",
-			Handler:    _Service_GetNodeInfo_Handler,
-		},
-		{
-			MethodName: "GetSyncing",
-			Handler:    _Service_GetSyncing_Handler,
-		},
-		{
-			MethodName: "GetLatestBlock",
-			Handler:    _Service_GetLatestBlock_Handler,
-		},
-		{
-			MethodName: "GetBlockByHeight",
-			Handler:    _Service_GetBlockByHeight_Handler,
-		},
-		{
-			MethodName: "GetLatestValidatorSet",
-			Handler:    _Service_GetLatestValidatorSet_Handler,
-		},
-		{
-			MethodName: "GetValidatorSetByHeight",
-			Handler:    _Service_GetValidatorSetByHeight_Handler,
-		},
-		{
-			MethodName: "ABCIQuery",
-			Handler:    _Service_ABCIQuery_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api/cosmos/forwarder/v1/query.proto",
+func (m *GetSyncingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSyncingRequest.Merge(m, src)
 }
-
-func (m *GetValidatorSetByHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GetSyncingRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *GetValidatorSetByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetSyncingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSyncingRequest.DiscardUnknown(m)
 }
 
-func (m *GetValidatorSetByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_GetSyncingRequest proto.InternalMessageInfo
 
-func (m *GetValidatorSetByHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// GetSyncingResponse is the response type for the Query/GetSyncing RPC method.
+type GetSyncingResponse struct {
+	Syncing bool `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
 }
 
-func (m *GetValidatorSetByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetSyncingResponse) Reset()         { *m = GetSyncingResponse{} }
+func (m *GetSyncingResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSyncingResponse) ProtoMessage()    {}
+func (*GetSyncingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{26}
 }
-
-func (m *GetValidatorSetByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *GetSyncingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSyncingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSyncingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *GetSyncingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSyncingResponse.Merge(m, src)
+}
+func (m *GetSyncingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSyncingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSyncingResponse.DiscardUnknown(m)
 }
 
-func (m *GetLatestValidatorSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSyncingResponse proto.InternalMessageInfo
+
+func (m *GetSyncingResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetLatestValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetNodeInfoRequest is the request type for the Query/GetNodeInfo RPC method.
+type GetNodeInfoRequest struct {
 }
 
-func (m *GetLatestValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *GetNodeInfoRequest) Reset()         { *m = GetNodeInfoRequest{} }
+func (m *GetNodeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNodeInfoRequest) ProtoMessage()    {}
+func (*GetNodeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{27}
+}
+func (m *GetNodeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNodeInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetNodeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeInfoRequest.Merge(m, src)
+}
+func (m *GetNodeInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNodeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeInfoRequest.DiscardUnknown(m)
 }
 
-func (m *GetLatestValidatorSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_GetNodeInfoRequest proto.InternalMessageInfo
 
-func (m *GetLatestValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GetNodeInfoResponse is the response type for the Query/GetNodeInfo RPC method.
+type GetNodeInfoResponse struct {
+	DefaultNodeInfo    *p2p.DefaultNodeInfo `protobuf:"bytes,1,opt,name=default_node_info,json=defaultNodeInfo,proto3" json:"default_node_info,omitempty"`
+	ApplicationVersion *VersionInfo         `protobuf:"bytes,2,opt,name=application_version,json=applicationVersion,proto3" json:"application_version,omitempty"`
 }
 
-func (m *GetLatestValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *GetNodeInfoResponse) Reset()         { *m = GetNodeInfoResponse{} }
+func (m *GetNodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNodeInfoResponse) ProtoMessage()    {}
+func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{28}
+}
+func (m *GetNodeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNodeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *GetNodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeInfoResponse.Merge(m, src)
+}
+func (m *GetNodeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeInfoResponse proto.InternalMessageInfo
+
+func (m *GetNodeInfoResponse) GetDefaultNodeInfo() *p2p.DefaultNodeInfo {
+	if m != nil {
+		return m.DefaultNodeInfo
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetNodeInfoResponse) GetApplicationVersion() *VersionInfo {
+	if m != nil {
+		return m.ApplicationVersion
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VersionInfo is the type for the GetNodeInfoResponse message.
+type VersionInfo struct {
+	Name      string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AppName   string    `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Version   string    `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	GitCommit string    `protobuf:"bytes,4,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	BuildTags string    `protobuf:"bytes,5,opt,name=build_tags,json=buildTags,proto3" json:"build_tags,omitempty"`
+	GoVersion string    `protobuf:"bytes,6,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	BuildDeps []*Module `protobuf:"bytes,7,rep,name=build_deps,json=buildDeps,proto3" json:"build_deps,omitempty"`
+	// Since: cosmos-sdk 0.43
+	CosmosSdkVersion string `protobuf:"bytes,8,opt,name=cosmos_sdk_version,json=cosmosSdkVersion,proto3" json:"cosmos_sdk_version,omitempty"`
 }
 
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposerPriority != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposerPriority))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PubKey != nil {
-		{
-			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *VersionInfo) Reset()         { *m = VersionInfo{} }
+func (m *VersionInfo) String() string { return proto.CompactTextString(m) }
+func (*VersionInfo) ProtoMessage()    {}
+func (*VersionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{29}
+}
+func (m *VersionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *VersionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionInfo.Merge(m, src)
+}
+func (m *VersionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionInfo proto.InternalMessageInfo
+
+func (m *VersionInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetBlockByHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VersionInfo) GetAppName() string {
+	if m != nil {
+		return m.AppName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBlockByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VersionInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
 
-func (m *GetBlockByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *VersionInfo) GetGitCommit() string {
+	if m != nil {
+		return m.GitCommit
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetBlockByHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VersionInfo) GetBuildTags() string {
+	if m != nil {
+		return m.BuildTags
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBlockByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VersionInfo) GetGoVersion() string {
+	if m != nil {
+		return m.GoVersion
+	}
+	return ""
 }
 
-func (m *GetBlockByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SdkBlock != nil {
-		{
-			size, err := m.SdkBlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *VersionInfo) GetBuildDeps() []*Module {
+	if m != nil {
+		return m.BuildDeps
 	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockId != nil {
-		{
-			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetLatestBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VersionInfo) GetCosmosSdkVersion() string {
+	if m != nil {
+		return m.CosmosSdkVersion
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetLatestBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Module is the type for VersionInfo
+type Module struct {
+	// module path
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// module version
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// checksum
+	Sum string `protobuf:"bytes,3,opt,name=sum,proto3" json:"sum,omitempty"`
 }
 
-func (m *GetLatestBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *Module) Reset()         { *m = Module{} }
+func (m *Module) String() string { return proto.CompactTextString(m) }
+func (*Module) ProtoMessage()    {}
+func (*Module) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{30}
 }
-
-func (m *GetLatestBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Module) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Module) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Module.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetLatestBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Module) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Module.Merge(m, src)
+}
+func (m *Module) XXX_Size() int {
+	return m.Size()
+}
+func (m *Module) XXX_DiscardUnknown() {
+	xxx_messageInfo_Module.DiscardUnknown(m)
 }
 
-func (m *GetLatestBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SdkBlock != nil {
-		{
-			size, err := m.SdkBlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockId != nil {
-		{
-			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_Module proto.InternalMessageInfo
+
+func (m *Module) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetSyncingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Module) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetSyncingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Module) GetSum() string {
+	if m != nil {
+		return m.Sum
+	}
+	return ""
 }
 
-func (m *GetSyncingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// ABCIQueryRequest defines the request structure for the ABCIQuery gRPC query.
+type ABCIQueryRequest struct {
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Path   string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Prove  bool   `protobuf:"varint,4,opt,name=prove,proto3" json:"prove,omitempty"`
 }
 
-func (m *GetSyncingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ABCIQueryRequest) Reset()         { *m = ABCIQueryRequest{} }
+func (m *ABCIQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*ABCIQueryRequest) ProtoMessage()    {}
+func (*ABCIQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{31}
 }
-
-func (m *GetSyncingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ABCIQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetSyncingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Syncing {
-		i--
-		if m.Syncing {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ABCIQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ABCIQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ABCIQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABCIQueryRequest.Merge(m, src)
+}
+func (m *ABCIQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABCIQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABCIQueryRequest.DiscardUnknown(m)
 }
 
-func (m *GetNodeInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ABCIQueryRequest proto.InternalMessageInfo
+
+func (m *ABCIQueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ABCIQueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-func (m *GetNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ABCIQueryRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *GetNodeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ABCIQueryRequest) GetProve() bool {
+	if m != nil {
+		return m.Prove
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ABCIQueryResponse defines the response structure for the ABCIQuery gRPC query.
+//
+// Note: This type is a duplicate of the ResponseQuery proto type defined in
+// Tendermint.
+type ABCIQueryResponse struct {
+	Code      uint32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Log       string    `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	Info      string    `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	Index     int64     `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	Key       []byte    `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte    `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	ProofOps  *ProofOps `protobuf:"bytes,8,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty"`
+	Height    int64     `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Codespace string    `protobuf:"bytes,10,opt,name=codespace,proto3" json:"codespace,omitempty"`
 }
 
-func (m *GetNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApplicationVersion != nil {
-		{
-			size, err := m.ApplicationVersion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DefaultNodeInfo != nil {
-		{
-			size, err := m.DefaultNodeInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ABCIQueryResponse) Reset()         { *m = ABCIQueryResponse{} }
+func (m *ABCIQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*ABCIQueryResponse) ProtoMessage()    {}
+func (*ABCIQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{32}
+}
+func (m *ABCIQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ABCIQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ABCIQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ABCIQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABCIQueryResponse.Merge(m, src)
+}
+func (m *ABCIQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABCIQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABCIQueryResponse.DiscardUnknown(m)
 }
 
-func (m *VersionInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ABCIQueryResponse proto.InternalMessageInfo
+
+func (m *ABCIQueryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VersionInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ABCIQueryResponse) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
 }
 
-func (m *VersionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CosmosSdkVersion) > 0 {
-		i -= len(m.CosmosSdkVersion)
-		copy(dAtA[i:], m.CosmosSdkVersion)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosSdkVersion)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.BuildDeps) > 0 {
-		for iNdEx := len(m.BuildDeps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuildDeps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.GoVersion) > 0 {
-		i -= len(m.GoVersion)
-		copy(dAtA[i:], m.GoVersion)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GoVersion)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.BuildTags) > 0 {
-		i -= len(m.BuildTags)
-		copy(dAtA[i:], m.BuildTags)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BuildTags)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.GitCommit) > 0 {
-		i -= len(m.GitCommit)
-		copy(dAtA[i:], m.GitCommit)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GitCommit)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AppName) > 0 {
-		i -= len(m.AppName)
-		copy(dAtA[i:], m.AppName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *ABCIQueryResponse) GetInfo() string {
+	if m != nil {
+		return m.Info
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Module) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ABCIQueryResponse) GetIndex() int64 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Module) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ABCIQueryResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
 }
 
-func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sum) > 0 {
-		i -= len(m.Sum)
-		copy(dAtA[i:], m.Sum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
-		i--
-		dAtA[i] = 0x1a
+func (m *ABCIQueryResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ABCIQueryResponse) GetProofOps() *ProofOps {
+	if m != nil {
+		return m.ProofOps
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ABCIQueryResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ABCIQueryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ABCIQueryResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ABCIQueryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProofOp defines an operation used for calculating Merkle root. The data could
+// be arbitrary format, providing necessary data for example neighbouring node
+// hash.
+//
+// Note: This type is a duplicate of the ProofOp proto type defined in Tendermint.
+type ProofOp struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key  []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *ABCIQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Prove {
-		i--
-		if m.Prove {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+func (m *ProofOp) Reset()         { *m = ProofOp{} }
+func (m *ProofOp) String() string { return proto.CompactTextString(m) }
+func (*ProofOp) ProtoMessage()    {}
+func (*ProofOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{33}
+}
+func (m *ProofOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ProofOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofOp.Merge(m, src)
+}
+func (m *ProofOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofOp proto.InternalMessageInfo
+
+func (m *ProofOp) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ProofOp) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ABCIQueryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProofOp) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ABCIQueryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProofOps is Merkle proof defined by the list of ProofOps.
+//
+// Note: This type is a duplicate of the ProofOps proto type defined in Tendermint.
+type ProofOps struct {
+	Ops []ProofOp `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops"`
 }
 
-func (m *ABCIQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Codespace) > 0 {
-		i -= len(m.Codespace)
-		copy(dAtA[i:], m.Codespace)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.ProofOps != nil {
-		{
-			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ProofOps) Reset()         { *m = ProofOps{} }
+func (m *ProofOps) String() string { return proto.CompactTextString(m) }
+func (*ProofOps) ProtoMessage()    {}
+func (*ProofOps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616aa04c2c794d7, []int{34}
+}
+func (m *ProofOps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofOps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Index != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Info) > 0 {
-		i -= len(m.Info)
-		copy(dAtA[i:], m.Info)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Info)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Log) > 0 {
-		i -= len(m.Log)
-		copy(dAtA[i:], m.Log)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Log)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Code != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProofOps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofOps.Merge(m, src)
+}
+func (m *ProofOps) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofOps) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofOps.DiscardUnknown(m)
 }
 
-func (m *ProofOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProofOps proto.InternalMessageInfo
+
+func (m *ProofOps) GetOps() []ProofOp {
+	if m != nil {
+		return m.Ops
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProofOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*GetValidatorSetByHeightRequest)(nil), "api.cosmos.forwarder.v1.GetValidatorSetByHeightRequest")
+	proto.RegisterType((*GetValidatorSetByHeightResponse)(nil), "api.cosmos.forwarder.v1.GetValidatorSetByHeightResponse")
+	proto.RegisterType((*GetLatestValidatorSetRequest)(nil), "api.cosmos.forwarder.v1.GetLatestValidatorSetRequest")
+	proto.RegisterType((*GetLatestValidatorSetResponse)(nil), "api.cosmos.forwarder.v1.GetLatestValidatorSetResponse")
+	proto.RegisterType((*GetBlockByHashRequest)(nil), "api.cosmos.forwarder.v1.GetBlockByHashRequest")
+	proto.RegisterType((*GetBlockByHashResponse)(nil), "api.cosmos.forwarder.v1.GetBlockByHashResponse")
+	proto.RegisterType((*GetTxByHashRequest)(nil), "api.cosmos.forwarder.v1.GetTxByHashRequest")
+	proto.RegisterType((*GetTxByHashResponse)(nil), "api.cosmos.forwarder.v1.GetTxByHashResponse")
+	proto.RegisterType((*IndexedBlock)(nil), "api.cosmos.forwarder.v1.IndexedBlock")
+	proto.RegisterType((*GetBlockRangeRequest)(nil), "api.cosmos.forwarder.v1.GetBlockRangeRequest")
+	proto.RegisterType((*GetBlockRangeResponse)(nil), "api.cosmos.forwarder.v1.GetBlockRangeResponse")
+	proto.RegisterType((*GetFullValidatorSetRequest)(nil), "api.cosmos.forwarder.v1.GetFullValidatorSetRequest")
+	proto.RegisterType((*GetFullValidatorSetResponse)(nil), "api.cosmos.forwarder.v1.GetFullValidatorSetResponse")
+	proto.RegisterType((*StreamValidatorSetResponse)(nil), "api.cosmos.forwarder.v1.StreamValidatorSetResponse")
+	proto.RegisterType((*GetValidatorSetDiffRequest)(nil), "api.cosmos.forwarder.v1.GetValidatorSetDiffRequest")
+	proto.RegisterType((*GetValidatorSetDiffResponse)(nil), "api.cosmos.forwarder.v1.GetValidatorSetDiffResponse")
+	proto.RegisterType((*ValidatorChange)(nil), "api.cosmos.forwarder.v1.ValidatorChange")
+	proto.RegisterType((*Validator)(nil), "api.cosmos.forwarder.v1.Validator")
+	proto.RegisterType((*GetBlockByHeightRequest)(nil), "api.cosmos.forwarder.v1.GetBlockByHeightRequest")
+	proto.RegisterType((*GetBlockByHeightResponse)(nil), "api.cosmos.forwarder.v1.GetBlockByHeightResponse")
+	proto.RegisterType((*GetDecodedBlockByHeightRequest)(nil), "api.cosmos.forwarder.v1.GetDecodedBlockByHeightRequest")
+	proto.RegisterType((*GetDecodedBlockByHeightResponse)(nil), "api.cosmos.forwarder.v1.GetDecodedBlockByHeightResponse")
+	proto.RegisterType((*DecodedTx)(nil), "api.cosmos.forwarder.v1.DecodedTx")
+	proto.RegisterType((*GetLatestBlockRequest)(nil), "api.cosmos.forwarder.v1.GetLatestBlockRequest")
+	proto.RegisterType((*GetLatestBlockResponse)(nil), "api.cosmos.forwarder.v1.GetLatestBlockResponse")
+	proto.RegisterType((*GetSyncingRequest)(nil), "api.cosmos.forwarder.v1.GetSyncingRequest")
+	proto.RegisterType((*GetSyncingResponse)(nil), "api.cosmos.forwarder.v1.GetSyncingResponse")
+	proto.RegisterType((*GetNodeInfoRequest)(nil), "api.cosmos.forwarder.v1.GetNodeInfoRequest")
+	proto.RegisterType((*GetNodeInfoResponse)(nil), "api.cosmos.forwarder.v1.GetNodeInfoResponse")
+	proto.RegisterType((*VersionInfo)(nil), "api.cosmos.forwarder.v1.VersionInfo")
+	proto.RegisterType((*Module)(nil), "api.cosmos.forwarder.v1.Module")
+	proto.RegisterType((*ABCIQueryRequest)(nil), "api.cosmos.forwarder.v1.ABCIQueryRequest")
+	proto.RegisterType((*ABCIQueryResponse)(nil), "api.cosmos.forwarder.v1.ABCIQueryResponse")
+	proto.RegisterType((*ProofOp)(nil), "api.cosmos.forwarder.v1.ProofOp")
+	proto.RegisterType((*ProofOps)(nil), "api.cosmos.forwarder.v1.ProofOps")
 }
 
-func (m *ProofOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("api/cosmos/forwarder/v1/query.proto", fileDescriptor_6616aa04c2c794d7)
 }
 
-func (m *ProofOps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_6616aa04c2c794d7 = []byte{
+	// 2255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0xb6, 0x48, 0x3e, 0xca, 0xb1, 0x34, 0x91, 0x6d, 0x9a, 0xb6, 0x65, 0x79, 0xdb,
+	0xc4, 0xb2, 0x6c, 0x71, 0x2d, 0x5a, 0x71, 0x04, 0x24, 0x35, 0x6a, 0x59, 0xb5, 0xac, 0xa6, 0x49,
+	0x9d, 0x95, 0x93, 0x43, 0x2e, 0x8b, 0x25, 0x77, 0xb8, 0x5a, 0x88, 0xdc, 0xd9, 0xec, 0x0e, 0x19,
+	0x32, 0x86, 0x81, 0xa0, 0x2d, 0xd0, 0x53, 0x81, 0x16, 0x05, 0xd2, 0x43, 0x4f, 0xe9, 0x29, 0xc7,
+	0x1c, 0x7a, 0x69, 0x5a, 0xf4, 0x50, 0xf4, 0x10, 0x34, 0x05, 0x9a, 0xb6, 0x97, 0x9e, 0x8a, 0xc2,
+	0x2e, 0x90, 0x7f, 0xa3, 0x98, 0x8f, 0x5d, 0xee, 0x52, 0xbb, 0xfc, 0x70, 0x8a, 0x16, 0xb9, 0x48,
+	0xb3, 0x6f, 0xde, 0x7b, 0xf3, 0xfb, 0xbd, 0x99, 0x79, 0xf3, 0x66, 0x08, 0xdf, 0x30, 0x3d, 0x47,
+	0x6b, 0x92, 0xa0, 0x43, 0x02, 0xad, 0x45, 0xfc, 0xf7, 0x4c, 0xdf, 0xc2, 0xbe, 0xd6, 0xdb, 0xd0,
+	0xde, 0xed, 0x62, 0x7f, 0x50, 0xf3, 0x7c, 0x42, 0x09, 0x3a, 0x6b, 0x7a, 0x4e, 0x4d, 0x28, 0xd5,
+	0x22, 0xa5, 0x5a, 0x6f, 0xa3, 0xba, 0x64, 0x13, 0x9b, 0x70, 0x1d, 0x8d, 0xb5, 0x84, 0x7a, 0xf5,
+	0x9c, 0x4d, 0x88, 0xdd, 0xc6, 0x1a, 0xff, 0x6a, 0x74, 0x5b, 0x9a, 0xe9, 0x4a, 0x4f, 0xd5, 0x0b,
+	0xb2, 0x8b, 0x8d, 0x6a, 0xba, 0x2e, 0xa1, 0x26, 0x75, 0x88, 0x1b, 0xc8, 0xde, 0x2a, 0xc5, 0xae,
+	0x85, 0xfd, 0x8e, 0xe3, 0x52, 0xcd, 0xab, 0x7b, 0x1a, 0x1d, 0x78, 0x38, 0xec, 0xbb, 0x10, 0xeb,
+	0xe3, 0xf2, 0x44, 0xef, 0x9a, 0xa4, 0xd0, 0x30, 0x03, 0x2c, 0xa0, 0x6b, 0xbd, 0x8d, 0x06, 0xa6,
+	0xe6, 0x86, 0xe6, 0x99, 0xb6, 0xe3, 0xf2, 0x61, 0xd2, 0x74, 0x63, 0x5e, 0x43, 0x83, 0xb8, 0xdf,
+	0x73, 0x42, 0xd7, 0x10, 0x1c, 0xc5, 0x47, 0x26, 0xa0, 0x46, 0x9b, 0x34, 0x0f, 0x65, 0xef, 0xa2,
+	0xd9, 0x71, 0x5c, 0xa2, 0xf1, 0xbf, 0x21, 0x3b, 0x39, 0x2e, 0xed, 0x0f, 0x87, 0xea, 0xcb, 0xbe,
+	0xcc, 0x69, 0x88, 0x81, 0x51, 0x3f, 0x50, 0x60, 0x79, 0x17, 0xd3, 0xb7, 0xcd, 0xb6, 0x63, 0x99,
+	0x94, 0xf8, 0xfb, 0x98, 0x6e, 0x0f, 0xee, 0x63, 0xc7, 0x3e, 0xa0, 0x3a, 0x7e, 0xb7, 0x8b, 0x03,
+	0x8a, 0xce, 0xc0, 0xdc, 0x01, 0x17, 0x54, 0x94, 0x15, 0x65, 0x35, 0xaf, 0xcb, 0x2f, 0x74, 0x0f,
+	0x60, 0x18, 0x87, 0x4a, 0x6e, 0x45, 0x59, 0x2d, 0xd7, 0x5f, 0x0c, 0xa7, 0x94, 0x05, 0xa2, 0x26,
+	0xe6, 0x5b, 0x02, 0xab, 0x3d, 0x30, 0x6d, 0x2c, 0x7d, 0xea, 0x31, 0x4b, 0xf5, 0xaf, 0x0a, 0x5c,
+	0xca, 0x84, 0x10, 0x78, 0xc4, 0x0d, 0x30, 0xba, 0x0c, 0xf3, 0x3c, 0x12, 0x46, 0x02, 0x49, 0x99,
+	0xcb, 0x84, 0x2a, 0xda, 0x06, 0xe8, 0x85, 0x2e, 0x82, 0x4a, 0x6e, 0x25, 0xbf, 0x5a, 0xae, 0xab,
+	0xb5, 0x8c, 0x55, 0x56, 0x8b, 0x46, 0xd3, 0x63, 0x56, 0x68, 0x37, 0x41, 0x29, 0xcf, 0x29, 0x5d,
+	0x99, 0x48, 0x49, 0x60, 0x4c, 0x70, 0x6a, 0xc1, 0x85, 0x5d, 0x4c, 0xbf, 0x67, 0x52, 0x1c, 0x24,
+	0x88, 0x85, 0x31, 0x4d, 0xc6, 0x4e, 0x79, 0xe6, 0xd8, 0xfd, 0x45, 0x81, 0x8b, 0x19, 0x03, 0x7d,
+	0x4d, 0x23, 0x77, 0x0d, 0x4e, 0xef, 0x62, 0xba, 0xcd, 0xe0, 0x6d, 0x0f, 0xee, 0x9b, 0xc1, 0x41,
+	0x18, 0x32, 0x04, 0xc7, 0x0f, 0xcc, 0xe0, 0x80, 0x13, 0x28, 0xe9, 0xbc, 0xad, 0xbe, 0x05, 0x67,
+	0x46, 0x95, 0x25, 0xed, 0x57, 0xe0, 0x04, 0xa7, 0x28, 0x63, 0xfb, 0x42, 0x26, 0x9d, 0x3d, 0xd7,
+	0xc2, 0x7d, 0x6c, 0x71, 0x1f, 0xba, 0xb0, 0x51, 0x57, 0x01, 0xed, 0x62, 0xfa, 0xb0, 0x3f, 0x19,
+	0xc0, 0x4f, 0x14, 0x78, 0x3e, 0xa1, 0x2a, 0x87, 0x4f, 0xd1, 0x8d, 0xed, 0xa3, 0x5c, 0x62, 0x1f,
+	0x2d, 0xc1, 0x09, 0x87, 0x81, 0xe0, 0x51, 0x3b, 0xa9, 0x8b, 0x0f, 0xb4, 0x09, 0x45, 0x31, 0x6f,
+	0x8e, 0x55, 0x39, 0xce, 0x39, 0x9c, 0xab, 0x0d, 0xb3, 0x43, 0x4d, 0xec, 0x61, 0x8e, 0x7a, 0x6f,
+	0x47, 0x2f, 0x70, 0xd5, 0x3d, 0x4b, 0xfd, 0x48, 0x81, 0xf9, 0x38, 0xa3, 0x84, 0x1b, 0x65, 0x5a,
+	0x37, 0xe8, 0x36, 0x83, 0x6a, 0x5a, 0xd8, 0x4f, 0xdd, 0xd6, 0x31, 0xfb, 0x70, 0x3a, 0xef, 0x73,
+	0x6d, 0x5d, 0x5a, 0xa1, 0xf3, 0x50, 0xa2, 0x7d, 0x83, 0xb1, 0xc6, 0x41, 0x25, 0xbf, 0x92, 0x5f,
+	0x2d, 0xe9, 0x45, 0xda, 0xbf, 0xcf, 0xbf, 0xd5, 0x1e, 0x2c, 0x85, 0x93, 0xa6, 0x9b, 0x6e, 0xb4,
+	0xae, 0xd1, 0x25, 0x28, 0xb7, 0x7c, 0xd2, 0x49, 0x2e, 0x54, 0x60, 0x22, 0xb9, 0x4e, 0x99, 0x57,
+	0x62, 0x24, 0x62, 0x58, 0xa4, 0x44, 0x76, 0xae, 0x40, 0xb9, 0x49, 0xdc, 0x66, 0xd7, 0xf7, 0xb1,
+	0xdb, 0x1c, 0xc8, 0x58, 0xc6, 0x45, 0x2c, 0xcf, 0x9c, 0x1e, 0x19, 0x58, 0xce, 0x56, 0x56, 0x86,
+	0x8b, 0x07, 0x2f, 0x37, 0x75, 0xf0, 0xd6, 0xc3, 0xa5, 0x27, 0x76, 0xc1, 0xd9, 0x0c, 0x13, 0xb9,
+	0xd8, 0xd0, 0x2b, 0x50, 0x0a, 0xac, 0x43, 0x43, 0x98, 0x88, 0x99, 0x5e, 0xce, 0x5c, 0xad, 0xc2,
+	0xb2, 0x18, 0x58, 0x87, 0xbc, 0xa5, 0xbe, 0x09, 0xd5, 0x5d, 0x4c, 0xef, 0x75, 0xdb, 0xed, 0xb4,
+	0x2c, 0x93, 0xc5, 0xeb, 0x3c, 0x94, 0x3c, 0xd3, 0xc6, 0x46, 0xe0, 0xbc, 0x8f, 0x39, 0xb1, 0xe3,
+	0x7a, 0x91, 0x09, 0xf6, 0x9d, 0xf7, 0xb1, 0xfa, 0x23, 0x05, 0xce, 0xa7, 0xfa, 0xfc, 0x9f, 0x26,
+	0x14, 0xf5, 0x87, 0x0a, 0x54, 0xf7, 0xa9, 0x8f, 0xcd, 0xce, 0xff, 0x13, 0xc5, 0x3b, 0x3c, 0xbe,
+	0x71, 0x04, 0x3b, 0x4e, 0xab, 0xf5, 0x5f, 0x59, 0xb1, 0xea, 0x2f, 0x72, 0x70, 0x3e, 0xd5, 0xb9,
+	0xa4, 0xf8, 0xd5, 0xf6, 0xc3, 0x16, 0x9c, 0x30, 0x2d, 0x0b, 0x5b, 0x95, 0xfc, 0xd4, 0xc4, 0x85,
+	0x01, 0x7a, 0x15, 0x0a, 0x3e, 0xee, 0x90, 0x1e, 0x66, 0x89, 0x67, 0x5a, 0xdb, 0xd0, 0x04, 0x6d,
+	0x43, 0xa1, 0x79, 0xc0, 0x76, 0x97, 0x55, 0x39, 0xc1, 0xad, 0x57, 0x27, 0x5b, 0xdf, 0xe5, 0x06,
+	0x7a, 0x68, 0xa8, 0x7e, 0x99, 0x83, 0x53, 0x23, 0x9d, 0xa8, 0x0e, 0x05, 0xd3, 0xb2, 0x7c, 0x1c,
+	0x04, 0x22, 0xa9, 0x6e, 0x57, 0xfe, 0xf6, 0xeb, 0xf5, 0x25, 0xe9, 0xf6, 0x8e, 0xe8, 0xd9, 0xa7,
+	0xbe, 0xe3, 0xda, 0x7a, 0xa8, 0x88, 0xd6, 0x60, 0x91, 0x47, 0xb0, 0x47, 0xa8, 0xe3, 0xda, 0x86,
+	0x47, 0xde, 0x93, 0x19, 0x2d, 0xaf, 0x9f, 0x62, 0x1d, 0x6f, 0x73, 0xf9, 0x03, 0x26, 0x46, 0x2f,
+	0xc2, 0x29, 0x4a, 0x92, 0x9a, 0x79, 0xae, 0x79, 0x92, 0x92, 0xb8, 0xde, 0x75, 0x40, 0x71, 0x25,
+	0xc3, 0xc2, 0x6d, 0x6a, 0xf2, 0x7d, 0x9b, 0xd7, 0x17, 0x7a, 0x43, 0xc5, 0x1d, 0x26, 0x47, 0x9b,
+	0x70, 0x86, 0x23, 0xf0, 0x7c, 0xe2, 0x91, 0x00, 0xfb, 0x86, 0xe7, 0x3b, 0xc4, 0x77, 0xe8, 0xa0,
+	0x72, 0x82, 0x5b, 0x2c, 0xb1, 0xde, 0x07, 0xb2, 0xf3, 0x81, 0xec, 0x43, 0x37, 0x60, 0x89, 0x92,
+	0x14, 0x9b, 0x39, 0x6e, 0x83, 0x28, 0x39, 0x62, 0x71, 0x0b, 0xce, 0x1e, 0x51, 0x97, 0xd0, 0x0a,
+	0xdc, 0xe8, 0xb4, 0x37, 0x62, 0xc2, 0xf1, 0xa9, 0xbf, 0x57, 0xa0, 0x14, 0x45, 0xfa, 0x99, 0x62,
+	0xbc, 0x0e, 0x05, 0xaf, 0xdb, 0x30, 0x0e, 0xf1, 0x40, 0xa6, 0xc8, 0xa5, 0x9a, 0xa8, 0xc7, 0x6b,
+	0x61, 0xa9, 0x5e, 0xbb, 0xe3, 0x0e, 0xf4, 0x39, 0xaf, 0xdb, 0x78, 0x0d, 0x0f, 0xd8, 0xbe, 0x4d,
+	0x89, 0x71, 0x39, 0x16, 0x38, 0x74, 0x0d, 0x16, 0x8f, 0x52, 0x97, 0x01, 0x1e, 0x65, 0xa1, 0x6e,
+	0xc0, 0xd9, 0x58, 0x05, 0x30, 0x4d, 0xdd, 0xaa, 0xfe, 0x4e, 0x81, 0xca, 0x51, 0x1b, 0xb9, 0xe9,
+	0x9e, 0xed, 0xbc, 0x8c, 0x52, 0x7e, 0x6e, 0xf6, 0x94, 0x9f, 0x9f, 0x31, 0xe5, 0x6f, 0xf1, 0x82,
+	0x7d, 0x07, 0x37, 0x89, 0x85, 0xad, 0x11, 0x12, 0xe3, 0x89, 0xff, 0x49, 0x14, 0xda, 0xe9, 0xa6,
+	0x5f, 0x89, 0x7f, 0x82, 0x50, 0x6e, 0x36, 0x42, 0x68, 0x13, 0xf2, 0xb4, 0x1f, 0x4c, 0xcc, 0x53,
+	0x12, 0xf6, 0xc3, 0xbe, 0xce, 0xd4, 0xd5, 0xdf, 0xe6, 0xa0, 0x14, 0x89, 0x52, 0xeb, 0xad, 0x1b,
+	0x50, 0xec, 0xe0, 0x20, 0x30, 0x6d, 0x1c, 0x66, 0xff, 0xf4, 0xa5, 0x19, 0x69, 0x31, 0x2f, 0x1d,
+	0xdc, 0x21, 0x7c, 0x4a, 0x4a, 0x3a, 0x6f, 0xa3, 0x17, 0xe0, 0x39, 0xea, 0x74, 0x30, 0xe9, 0xd2,
+	0x30, 0xd3, 0x1e, 0xe7, 0x07, 0xe6, 0x49, 0x29, 0x95, 0xe9, 0x76, 0x15, 0xf2, 0x2d, 0x8c, 0xf9,
+	0xae, 0x2e, 0xd7, 0xcf, 0x84, 0x04, 0x68, 0x3f, 0xaa, 0x90, 0xee, 0x61, 0xac, 0x33, 0x15, 0xf4,
+	0x6d, 0x98, 0x0f, 0x1c, 0xdb, 0xc5, 0xbe, 0xe1, 0xb8, 0x2d, 0x12, 0x54, 0xe6, 0x38, 0xb4, 0x8b,
+	0x29, 0x26, 0xfb, 0x5c, 0x6d, 0xcf, 0x6d, 0x11, 0xbd, 0x1c, 0x44, 0xed, 0x00, 0x2d, 0x03, 0xb0,
+	0x4f, 0x93, 0x76, 0x7d, 0x1c, 0x54, 0x0a, 0x2b, 0xf9, 0xd5, 0x79, 0x3d, 0x26, 0x61, 0x7b, 0xcc,
+	0xe2, 0x91, 0x31, 0xb0, 0xef, 0x13, 0xbf, 0x52, 0xe4, 0x74, 0xca, 0x42, 0xf6, 0x1d, 0x26, 0x52,
+	0xcf, 0xc2, 0xe9, 0xe8, 0xda, 0x20, 0xe6, 0x43, 0xac, 0x1d, 0xf5, 0x53, 0x05, 0xce, 0x8c, 0xf6,
+	0x7c, 0x6d, 0xb6, 0xc6, 0xf3, 0xb0, 0xb8, 0x8b, 0xe9, 0xfe, 0xc0, 0x6d, 0xb2, 0x14, 0x25, 0x19,
+	0xd5, 0x00, 0xc5, 0x85, 0x92, 0x4c, 0x05, 0x0a, 0x81, 0x10, 0x71, 0x2e, 0x45, 0x3d, 0xfc, 0x54,
+	0x97, 0xb8, 0xfe, 0x1b, 0xc4, 0xc2, 0x3c, 0xf2, 0xd2, 0xcb, 0x6f, 0x44, 0xa1, 0x3f, 0x14, 0x4b,
+	0x3f, 0xaf, 0xc1, 0xa2, 0x85, 0x5b, 0x66, 0xb7, 0x4d, 0x0d, 0x97, 0x45, 0x9c, 0xcd, 0xa9, 0x8c,
+	0xce, 0xa5, 0x38, 0x55, 0xaf, 0xee, 0xd5, 0x76, 0x84, 0x62, 0xe4, 0xe3, 0x94, 0x95, 0x14, 0xa0,
+	0xb7, 0xe0, 0x79, 0xd3, 0xf3, 0xda, 0x4e, 0x93, 0x5f, 0x85, 0x8c, 0x1e, 0xf6, 0x83, 0xe1, 0xd5,
+	0xfa, 0x9b, 0xd9, 0xe7, 0xa8, 0xd0, 0xe3, 0x3e, 0x51, 0xcc, 0x81, 0x94, 0xab, 0x1f, 0xe5, 0xa0,
+	0x1c, 0xd3, 0x61, 0xcb, 0xdc, 0x35, 0x3b, 0x38, 0xdc, 0x2c, 0xac, 0x8d, 0xce, 0x41, 0xd1, 0xf4,
+	0x3c, 0x83, 0xcb, 0x73, 0x5c, 0x5e, 0x30, 0x3d, 0xef, 0x0d, 0xd6, 0x55, 0x81, 0x42, 0x88, 0x44,
+	0x6c, 0x8c, 0xf0, 0x13, 0x5d, 0x04, 0xb0, 0x1d, 0x6a, 0x34, 0x49, 0xa7, 0xe3, 0x88, 0x7d, 0x51,
+	0xd2, 0x4b, 0xb6, 0x43, 0xef, 0x72, 0x01, 0xeb, 0x6e, 0x74, 0x9d, 0xb6, 0x65, 0x50, 0xd3, 0x0e,
+	0xf8, 0xd6, 0x28, 0xe9, 0x25, 0x2e, 0x79, 0x68, 0xda, 0x01, 0xb7, 0x26, 0x11, 0xc9, 0x39, 0x69,
+	0x4d, 0x24, 0x52, 0x74, 0x3b, 0xb4, 0xb6, 0xb0, 0x27, 0x56, 0x39, 0x0b, 0x69, 0x56, 0x0c, 0x5e,
+	0x27, 0x56, 0xb7, 0x8d, 0xa5, 0xfb, 0x1d, 0xec, 0x05, 0xec, 0xa0, 0x16, 0x8a, 0x06, 0x5b, 0x50,
+	0xe1, 0x30, 0x62, 0x2f, 0x2c, 0x88, 0x9e, 0x7d, 0xeb, 0x30, 0x8c, 0xd1, 0x7d, 0x98, 0x13, 0x2e,
+	0x58, 0x74, 0x3c, 0x93, 0x46, 0xa9, 0x84, 0xb5, 0xe3, 0x21, 0xc8, 0x25, 0x43, 0xb0, 0x00, 0xf9,
+	0xa0, 0xdb, 0x91, 0x81, 0x61, 0x4d, 0xf5, 0x00, 0x16, 0xee, 0x6c, 0xdf, 0xdd, 0x7b, 0x93, 0x5d,
+	0x77, 0x63, 0x57, 0x47, 0xcb, 0xa4, 0x26, 0xf7, 0x39, 0xaf, 0xf3, 0x76, 0x34, 0x4e, 0x2e, 0x36,
+	0xce, 0x30, 0x73, 0xe7, 0x47, 0xaf, 0x88, 0x9e, 0x4f, 0x7a, 0x98, 0xc7, 0xb8, 0xa8, 0x8b, 0x0f,
+	0xf5, 0xc7, 0x39, 0x58, 0x8c, 0x0d, 0x35, 0xbc, 0x7a, 0xb2, 0x7d, 0xce, 0xc7, 0x3a, 0xa9, 0xf3,
+	0x36, 0x43, 0xd9, 0x26, 0x76, 0x88, 0xb2, 0x4d, 0x6c, 0xa6, 0xc5, 0x97, 0xaa, 0x98, 0x34, 0xde,
+	0x1e, 0x5e, 0x44, 0x45, 0x6d, 0x22, 0x3e, 0x98, 0x2d, 0x3b, 0xdc, 0xe7, 0x38, 0x74, 0xd6, 0x64,
+	0x7a, 0x3d, 0xb3, 0xdd, 0xc5, 0xbc, 0xb4, 0x98, 0xd7, 0xc5, 0x07, 0xba, 0x0d, 0x25, 0xcf, 0x27,
+	0xa4, 0x65, 0x10, 0x2f, 0xe0, 0x61, 0x2e, 0xd7, 0x2f, 0x67, 0x4e, 0xd7, 0x03, 0xa6, 0xf9, 0x7d,
+	0x2f, 0xd0, 0x8b, 0x9e, 0x6c, 0xc5, 0xb8, 0x97, 0x12, 0xdc, 0x2f, 0x40, 0x89, 0x71, 0x08, 0x3c,
+	0xb3, 0x89, 0x2b, 0x20, 0x56, 0x49, 0x24, 0xf8, 0xee, 0xf1, 0x62, 0x6e, 0x21, 0xaf, 0xde, 0x85,
+	0x82, 0xf4, 0xc8, 0x88, 0xb1, 0xb4, 0x12, 0x4e, 0x1f, 0x6b, 0x87, 0x14, 0x72, 0x43, 0x0a, 0xe1,
+	0x84, 0xe4, 0x87, 0x13, 0xa2, 0xee, 0x41, 0x31, 0x84, 0x85, 0xbe, 0x05, 0x79, 0x46, 0x43, 0xe1,
+	0xab, 0x6e, 0x65, 0x12, 0x8d, 0xed, 0xd2, 0x67, 0xff, 0xbc, 0x74, 0xec, 0xe3, 0x2f, 0x3f, 0x59,
+	0x53, 0x74, 0x66, 0x57, 0xff, 0x1c, 0x41, 0x61, 0x1f, 0xfb, 0x3d, 0xa7, 0x89, 0xd1, 0x2f, 0x15,
+	0x28, 0xc7, 0x32, 0x07, 0xba, 0x96, 0xe9, 0xed, 0x68, 0xda, 0xa9, 0x5e, 0x9f, 0x4e, 0x59, 0x4c,
+	0xbd, 0xba, 0xf1, 0x83, 0xbf, 0xff, 0xfb, 0xe7, 0xb9, 0x6b, 0xe8, 0xaa, 0x36, 0xe1, 0x39, 0x32,
+	0x4a, 0x55, 0xe8, 0x43, 0x05, 0x60, 0x98, 0x1e, 0xd1, 0xda, 0xb8, 0xf1, 0x92, 0x89, 0xb5, 0x7a,
+	0x6d, 0x2a, 0x5d, 0x09, 0x4d, 0xe3, 0xd0, 0xae, 0xa2, 0x2b, 0x93, 0xa0, 0xc9, 0x34, 0x8c, 0x3e,
+	0x56, 0xe0, 0xb9, 0xe4, 0x41, 0x84, 0x6a, 0xe3, 0x06, 0x3c, 0x7a, 0x96, 0x55, 0xb5, 0xa9, 0xf5,
+	0x25, 0xc8, 0x97, 0x38, 0x48, 0x0d, 0xad, 0x4f, 0x02, 0xc9, 0x8f, 0xa7, 0x40, 0x6b, 0x73, 0x1f,
+	0xe8, 0x13, 0x05, 0x16, 0x46, 0x0b, 0x4a, 0x74, 0x63, 0xdc, 0xe0, 0x69, 0x65, 0x5b, 0x75, 0x63,
+	0x06, 0x0b, 0x09, 0xf8, 0x65, 0x0e, 0x78, 0x03, 0x69, 0x53, 0x02, 0x7e, 0x24, 0xf6, 0xd4, 0x63,
+	0xf4, 0x47, 0x25, 0x56, 0x00, 0xc4, 0x6f, 0xa0, 0xe8, 0xa5, 0xc9, 0x41, 0x4b, 0x79, 0x6a, 0xa8,
+	0xde, 0x9a, 0xd5, 0x4c, 0x32, 0x78, 0x95, 0x33, 0xb8, 0x85, 0x36, 0x27, 0x31, 0x18, 0x5e, 0xca,
+	0x31, 0x8d, 0x22, 0xff, 0x67, 0x85, 0x97, 0xff, 0x69, 0x4f, 0xc7, 0xe8, 0xe5, 0x71, 0x88, 0xc6,
+	0xbc, 0x77, 0x57, 0xb7, 0x66, 0x37, 0x94, 0x64, 0x6e, 0x73, 0x32, 0x5b, 0xe8, 0xd6, 0x6c, 0x64,
+	0xa2, 0x59, 0xf9, 0x50, 0x81, 0x52, 0x94, 0xd0, 0xd1, 0xd5, 0x4c, 0x1c, 0xa3, 0xe7, 0x4b, 0x75,
+	0x6d, 0x1a, 0x55, 0x09, 0xb2, 0xce, 0x41, 0x5e, 0x47, 0x6b, 0x93, 0x40, 0x9a, 0x8d, 0xa6, 0x63,
+	0xf0, 0x57, 0x5b, 0xf4, 0x07, 0x11, 0xe7, 0xb4, 0x9b, 0xc3, 0xf8, 0x38, 0x8f, 0xb9, 0xa6, 0x54,
+	0xb7, 0x66, 0x37, 0x94, 0x14, 0x36, 0x39, 0x85, 0x1a, 0xba, 0x9e, 0xfa, 0xf3, 0x86, 0xa8, 0x73,
+	0x2d, 0x63, 0x74, 0xcd, 0x7f, 0x2a, 0x4a, 0xb8, 0xd1, 0x87, 0x2d, 0x74, 0x73, 0x1c, 0x8e, 0x8c,
+	0xa7, 0xb5, 0xea, 0xe6, 0x6c, 0x46, 0x12, 0xf8, 0x16, 0x07, 0x5e, 0x47, 0x37, 0x52, 0x81, 0xa7,
+	0xaf, 0x0a, 0xad, 0xd5, 0x6d, 0xb7, 0xd1, 0x07, 0x0a, 0xa0, 0xa3, 0xcf, 0x61, 0xcf, 0x86, 0x3d,
+	0xdb, 0x28, 0xfb, 0xc1, 0xed, 0x86, 0x82, 0x3e, 0x17, 0xf1, 0x1b, 0x7d, 0xaf, 0x1a, 0x8f, 0x21,
+	0xe3, 0xe9, 0xac, 0xba, 0x39, 0x9b, 0x91, 0x8c, 0xdf, 0x1e, 0x8f, 0xdf, 0x5d, 0x74, 0x67, 0x9a,
+	0xf8, 0xc5, 0x1e, 0xcf, 0x1e, 0x6b, 0x96, 0xd3, 0x6a, 0x69, 0x8f, 0xa2, 0xe7, 0xb2, 0xc7, 0xe8,
+	0x57, 0xe2, 0x7c, 0x89, 0xfd, 0x76, 0x30, 0xfe, 0x7c, 0x39, 0xfa, 0x8b, 0x44, 0x55, 0x9b, 0x5a,
+	0x3f, 0xeb, 0x10, 0x4c, 0xc0, 0x97, 0xeb, 0x95, 0xdd, 0x5d, 0xb5, 0x47, 0xec, 0xef, 0x63, 0xf4,
+	0x33, 0x51, 0x3b, 0x84, 0x3f, 0x2f, 0x8c, 0xaf, 0x1d, 0x46, 0x7e, 0xaf, 0xa8, 0x5e, 0x9f, 0x4e,
+	0x59, 0x62, 0xbb, 0xc2, 0xb1, 0x5d, 0x46, 0x97, 0x52, 0xb1, 0xd1, 0x7e, 0x10, 0x62, 0xf2, 0xe0,
+	0x64, 0xe2, 0x15, 0x1d, 0xad, 0x4f, 0x0c, 0x43, 0xfc, 0x99, 0xbf, 0x5a, 0x9b, 0x56, 0x3d, 0x5c,
+	0x78, 0xdb, 0xaf, 0x7f, 0xf6, 0x64, 0x59, 0xf9, 0xe2, 0xc9, 0xb2, 0xf2, 0xaf, 0x27, 0xcb, 0xca,
+	0x4f, 0x9f, 0x2e, 0x1f, 0xfb, 0xe2, 0xe9, 0xf2, 0xb1, 0x7f, 0x3c, 0x5d, 0x3e, 0xf6, 0xce, 0x4d,
+	0xdb, 0xa1, 0x07, 0xdd, 0x46, 0xad, 0x49, 0x3a, 0x21, 0x6c, 0xf1, 0x6f, 0x3d, 0xb0, 0x0e, 0xb5,
+	0x66, 0xdb, 0xc1, 0x2e, 0xd5, 0x6c, 0xdf, 0x6b, 0x6a, 0xcd, 0x0e, 0x0d, 0x44, 0x41, 0xd6, 0x98,
+	0xe3, 0xb7, 0xff, 0x9b, 0xff, 0x19, 0x00, 0xa7, 0xa4, 0x13, 0xaa, 0xa7, 0x1e, 0x00, 0x00,
 }
 
-func (m *ProofOps) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *ProofOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ops) > 0 {
-		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// GetNodeInfo queries the current node info.
+	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error)
+	// GetSyncing queries node syncing.
+	GetSyncing(ctx context.Context, in *GetSyncingRequest, opts ...grpc.CallOption) (*GetSyncingResponse, error)
+	// GetLatestBlock returns the latest block.
+	GetLatestBlock(ctx context.Context, in *GetLatestBlockRequest, opts ...grpc.CallOption) (*GetLatestBlockResponse, error)
+	// GetBlockByHeight queries block for given height.
+	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockByHeightResponse, error)
+	// GetLatestValidatorSet queries latest validator-set.
+	GetLatestValidatorSet(ctx context.Context, in *GetLatestValidatorSetRequest, opts ...grpc.CallOption) (*GetLatestValidatorSetResponse, error)
+	// GetValidatorSetByHeight queries validator-set at a given height.
+	GetValidatorSetByHeight(ctx context.Context, in *GetValidatorSetByHeightRequest, opts ...grpc.CallOption) (*GetValidatorSetByHeightResponse, error)
+	// ABCIQuery defines a query handler that supports ABCI queries directly to the
+	// application, bypassing Tendermint completely. The ABCI query must contain
+	// a valid and supported path, including app, custom, p2p, and store.
+	//
+	// Since: cosmos-sdk 0.46
+	ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error)
+	// GetDecodedBlockByHeight queries block for given height with all of its
+	// transactions decoded into messages, fee, memo and signer info.
+	GetDecodedBlockByHeight(ctx context.Context, in *GetDecodedBlockByHeightRequest, opts ...grpc.CallOption) (*GetDecodedBlockByHeightResponse, error)
+	// GetFullValidatorSet queries the complete validator-set at a given height by
+	// walking all upstream pages. All pages are pinned to the same height.
+	GetFullValidatorSet(ctx context.Context, in *GetFullValidatorSetRequest, opts ...grpc.CallOption) (*GetFullValidatorSetResponse, error)
+	// StreamValidatorSet streams the complete validator-set at a given height one
+	// upstream page at a time. All pages are pinned to the same height.
+	StreamValidatorSet(ctx context.Context, in *GetFullValidatorSetRequest, opts ...grpc.CallOption) (Service_StreamValidatorSetClient, error)
+	// GetValidatorSetDiff compares the complete validator-sets at two heights and
+	// returns the added and removed validators and the voting power and proposer
+	// priority changes, keyed by address.
+	GetValidatorSetDiff(ctx context.Context, in *GetValidatorSetDiffRequest, opts ...grpc.CallOption) (*GetValidatorSetDiffResponse, error)
+	// GetBlockByHash queries a block header by block hash from the local index.
+	// It requires the indexer to be enabled.
+	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*GetBlockByHashResponse, error)
+	// GetTxByHash queries the block height and position of a transaction by
+	// transaction hash from the local index. It requires the indexer to be enabled,
+	// but not the tx indexer of the upstream node.
+	GetTxByHash(ctx context.Context, in *GetTxByHashRequest, opts ...grpc.CallOption) (*GetTxByHashResponse, error)
+	// GetBlockRange streams the blocks from from_height to to_height inclusive in
+	// height order. Blocks are fetched concurrently. To resume after a disconnect,
+	// call it again with from_height set to the last delivered height plus one.
+	GetBlockRange(ctx context.Context, in *GetBlockRangeRequest, opts ...grpc.CallOption) (Service_GetBlockRangeClient, error)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type serviceClient struct {
+	cc grpc1.ClientConn
 }
-func (m *GetValidatorSetByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
 }
 
-func (m *GetValidatorSetByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *serviceClient) GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error) {
+	out := new(GetNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) GetSyncing(ctx context.Context, in *GetSyncingRequest, opts ...grpc.CallOption) (*GetSyncingResponse, error) {
+	out := new(GetSyncingResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetSyncing", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetLatestValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) GetLatestBlock(ctx context.Context, in *GetLatestBlockRequest, opts ...grpc.CallOption) (*GetLatestBlockResponse, error) {
+	out := new(GetLatestBlockResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetLatestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockByHeightResponse, error) {
+	out := new(GetBlockByHeightResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetBlockByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetLatestValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) GetLatestValidatorSet(ctx context.Context, in *GetLatestValidatorSetRequest, opts ...grpc.CallOption) (*GetLatestValidatorSetResponse, error) {
+	out := new(GetLatestValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetLatestValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	return out, nil
+}
+
+func (c *serviceClient) GetValidatorSetByHeight(ctx context.Context, in *GetValidatorSetByHeightRequest, opts ...grpc.CallOption) (*GetValidatorSetByHeightResponse, error) {
+	out := new(GetValidatorSetByHeightResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetValidatorSetByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *serviceClient) ABCIQuery(ctx context.Context, in *ABCIQueryRequest, opts ...grpc.CallOption) (*ABCIQueryResponse, error) {
+	out := new(ABCIQueryResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/ABCIQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) GetDecodedBlockByHeight(ctx context.Context, in *GetDecodedBlockByHeightRequest, opts ...grpc.CallOption) (*GetDecodedBlockByHeightResponse, error) {
+	out := new(GetDecodedBlockByHeightResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetDecodedBlockByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) GetFullValidatorSet(ctx context.Context, in *GetFullValidatorSetRequest, opts ...grpc.CallOption) (*GetFullValidatorSetResponse, error) {
+	out := new(GetFullValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetFullValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) StreamValidatorSet(ctx context.Context, in *GetFullValidatorSetRequest, opts ...grpc.CallOption) (Service_StreamValidatorSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/api.cosmos.forwarder.v1.Service/StreamValidatorSet", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	x := &serviceStreamValidatorSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.ProposerPriority != 0 {
-		n += 1 + sovQuery(uint64(m.ProposerPriority))
+	return x, nil
+}
+
+type Service_StreamValidatorSetClient interface {
+	Recv() (*StreamValidatorSetResponse, error)
+	grpc.ClientStream
+}
+
+type serviceStreamValidatorSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceStreamValidatorSetClient) Recv() (*StreamValidatorSetResponse, error) {
+	m := new(StreamValidatorSetResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *GetBlockByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) GetValidatorSetDiff(ctx context.Context, in *GetValidatorSetDiffRequest, opts ...grpc.CallOption) (*GetValidatorSetDiffResponse, error) {
+	out := new(GetValidatorSetDiffResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetValidatorSetDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	return out, nil
+}
+
+func (c *serviceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*GetBlockByHashResponse, error) {
+	out := new(GetBlockByHashResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetBlockByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetBlockByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *serviceClient) GetTxByHash(ctx context.Context, in *GetTxByHashRequest, opts ...grpc.CallOption) (*GetTxByHashResponse, error) {
+	out := new(GetTxByHashResponse)
+	err := c.cc.Invoke(ctx, "/api.cosmos.forwarder.v1.Service/GetTxByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BlockId != nil {
-		l = m.BlockId.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *serviceClient) GetBlockRange(ctx context.Context, in *GetBlockRangeRequest, opts ...grpc.CallOption) (Service_GetBlockRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[1], "/api.cosmos.forwarder.v1.Service/GetBlockRange", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	x := &serviceGetBlockRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.SdkBlock != nil {
-		l = m.SdkBlock.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *GetLatestBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type Service_GetBlockRangeClient interface {
+	Recv() (*GetBlockRangeResponse, error)
+	grpc.ClientStream
 }
 
-func (m *GetLatestBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type serviceGetBlockRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceGetBlockRangeClient) Recv() (*GetBlockRangeResponse, error) {
+	m := new(GetBlockRangeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BlockId != nil {
-		l = m.BlockId.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SdkBlock != nil {
-		l = m.SdkBlock.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return m, nil
 }
 
-func (m *GetSyncingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// GetNodeInfo queries the current node info.
+	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error)
+	// GetSyncing queries node syncing.
+	GetSyncing(context.Context, *GetSyncingRequest) (*GetSyncingResponse, error)
+	// GetLatestBlock returns the latest block.
+	GetLatestBlock(context.Context, *GetLatestBlockRequest) (*GetLatestBlockResponse, error)
+	// GetBlockByHeight queries block for given height.
+	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockByHeightResponse, error)
+	// GetLatestValidatorSet queries latest validator-set.
+	GetLatestValidatorSet(context.Context, *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error)
+	// GetValidatorSetByHeight queries validator-set at a given height.
+	GetValidatorSetByHeight(context.Context, *GetValidatorSetByHeightRequest) (*GetValidatorSetByHeightResponse, error)
+	// ABCIQuery defines a query handler that supports ABCI queries directly to the
+	// application, bypassing Tendermint completely. The ABCI query must contain
+	// a valid and supported path, including app, custom, p2p, and store.
+	//
+	// Since: cosmos-sdk 0.46
+	ABCIQuery(context.Context, *ABCIQueryRequest) (*ABCIQueryResponse, error)
+	// GetDecodedBlockByHeight queries block for given height with all of its
+	// transactions decoded into messages, fee, memo and signer info.
+	GetDecodedBlockByHeight(context.Context, *GetDecodedBlockByHeightRequest) (*GetDecodedBlockByHeightResponse, error)
+	// GetFullValidatorSet queries the complete validator-set at a given height by
+	// walking all upstream pages. All pages are pinned to the same height.
+	GetFullValidatorSet(context.Context, *GetFullValidatorSetRequest) (*GetFullValidatorSetResponse, error)
+	// StreamValidatorSet streams the complete validator-set at a given height one
+	// upstream page at a time. All pages are pinned to the same height.
+	StreamValidatorSet(*GetFullValidatorSetRequest, Service_StreamValidatorSetServer) error
+	// GetValidatorSetDiff compares the complete validator-sets at two heights and
+	// returns the added and removed validators and the voting power and proposer
+	// priority changes, keyed by address.
+	GetValidatorSetDiff(context.Context, *GetValidatorSetDiffRequest) (*GetValidatorSetDiffResponse, error)
+	// GetBlockByHash queries a block header by block hash from the local index.
+	// It requires the indexer to be enabled.
+	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*GetBlockByHashResponse, error)
+	// GetTxByHash queries the block height and position of a transaction by
+	// transaction hash from the local index. It requires the indexer to be enabled,
+	// but not the tx indexer of the upstream node.
+	GetTxByHash(context.Context, *GetTxByHashRequest) (*GetTxByHashResponse, error)
+	// GetBlockRange streams the blocks from from_height to to_height inclusive in
+	// height order. Blocks are fetched concurrently. To resume after a disconnect,
+	// call it again with from_height set to the last delivered height plus one.
+	GetBlockRange(*GetBlockRangeRequest, Service_GetBlockRangeServer) error
 }
 
-func (m *GetSyncingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Syncing {
-		n += 2
-	}
-	return n
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
 }
 
-func (m *GetNodeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedServiceServer) GetNodeInfo(ctx context.Context, req *GetNodeInfoRequest) (*GetNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+}
+func (*UnimplementedServiceServer) GetSyncing(ctx context.Context, req *GetSyncingRequest) (*GetSyncingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncing not implemented")
+}
+func (*UnimplementedServiceServer) GetLatestBlock(ctx context.Context, req *GetLatestBlockRequest) (*GetLatestBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestBlock not implemented")
+}
+func (*UnimplementedServiceServer) GetBlockByHeight(ctx context.Context, req *GetBlockByHeightRequest) (*GetBlockByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
+}
+func (*UnimplementedServiceServer) GetLatestValidatorSet(ctx context.Context, req *GetLatestValidatorSetRequest) (*GetLatestValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestValidatorSet not implemented")
+}
+func (*UnimplementedServiceServer) GetValidatorSetByHeight(ctx context.Context, req *GetValidatorSetByHeightRequest) (*GetValidatorSetByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetByHeight not implemented")
+}
+func (*UnimplementedServiceServer) ABCIQuery(ctx context.Context, req *ABCIQueryRequest) (*ABCIQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ABCIQuery not implemented")
+}
+func (*UnimplementedServiceServer) GetDecodedBlockByHeight(ctx context.Context, req *GetDecodedBlockByHeightRequest) (*GetDecodedBlockByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecodedBlockByHeight not implemented")
+}
+func (*UnimplementedServiceServer) GetFullValidatorSet(ctx context.Context, req *GetFullValidatorSetRequest) (*GetFullValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFullValidatorSet not implemented")
+}
+func (*UnimplementedServiceServer) StreamValidatorSet(req *GetFullValidatorSetRequest, srv Service_StreamValidatorSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValidatorSet not implemented")
+}
+func (*UnimplementedServiceServer) GetValidatorSetDiff(ctx context.Context, req *GetValidatorSetDiffRequest) (*GetValidatorSetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorSetDiff not implemented")
+}
+func (*UnimplementedServiceServer) GetBlockByHash(ctx context.Context, req *GetBlockByHashRequest) (*GetBlockByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (*UnimplementedServiceServer) GetTxByHash(ctx context.Context, req *GetTxByHashRequest) (*GetTxByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxByHash not implemented")
+}
+func (*UnimplementedServiceServer) GetBlockRange(req *GetBlockRangeRequest, srv Service_GetBlockRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetBlockRange not implemented")
 }
 
-func (m *GetNodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DefaultNodeInfo != nil {
-		l = m.DefaultNodeInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetNodeInfo(ctx, in)
 	}
-	if m.ApplicationVersion != nil {
-		l = m.ApplicationVersion.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetNodeInfo",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetNodeInfo(ctx, req.(*GetNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VersionInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Service_GetSyncing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.AppName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetSyncing(ctx, in)
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetSyncing",
 	}
-	l = len(m.GitCommit)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetSyncing(ctx, req.(*GetSyncingRequest))
 	}
-	l = len(m.BuildTags)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetLatestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.GoVersion)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetLatestBlock(ctx, in)
 	}
-	if len(m.BuildDeps) > 0 {
-		for _, e := range m.BuildDeps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetLatestBlock",
 	}
-	l = len(m.CosmosSdkVersion)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetLatestBlock(ctx, req.(*GetLatestBlockRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Module) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockByHeight(ctx, in)
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetBlockByHeight",
 	}
-	l = len(m.Sum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ABCIQueryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Service_GetLatestValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetLatestValidatorSet(ctx, in)
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetLatestValidatorSet",
 	}
-	if m.Prove {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetLatestValidatorSet(ctx, req.(*GetLatestValidatorSetRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ABCIQueryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_GetValidatorSetByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorSetByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovQuery(uint64(m.Code))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetValidatorSetByHeight(ctx, in)
 	}
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetValidatorSetByHeight",
 	}
-	l = len(m.Info)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetValidatorSetByHeight(ctx, req.(*GetValidatorSetByHeightRequest))
 	}
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ABCIQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ABCIQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).ABCIQuery(ctx, in)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/ABCIQuery",
 	}
-	if m.ProofOps != nil {
-		l = m.ProofOps.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ABCIQuery(ctx, req.(*ABCIQueryRequest))
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetDecodedBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDecodedBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Codespace)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(ServiceServer).GetDecodedBlockByHeight(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetDecodedBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetDecodedBlockByHeight(ctx, req.(*GetDecodedBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProofOp) Size() (n int) {
-	if m == nil {
-		return 0
+func _Service_GetFullValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFullValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetFullValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetFullValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetFullValidatorSet(ctx, req.(*GetFullValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_StreamValidatorSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFullValidatorSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).StreamValidatorSet(m, &serviceStreamValidatorSetServer{stream})
+}
+
+type Service_StreamValidatorSetServer interface {
+	Send(*StreamValidatorSetResponse) error
+	grpc.ServerStream
+}
+
+type serviceStreamValidatorSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceStreamValidatorSetServer) Send(m *StreamValidatorSetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_GetValidatorSetDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorSetDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetValidatorSetDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetValidatorSetDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetValidatorSetDiff(ctx, req.(*GetValidatorSetDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetTxByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetTxByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.cosmos.forwarder.v1.Service/GetTxByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetTxByHash(ctx, req.(*GetTxByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetBlockRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBlockRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).GetBlockRange(m, &serviceGetBlockRangeServer{stream})
+}
+
+type Service_GetBlockRangeServer interface {
+	Send(*GetBlockRangeResponse) error
+	grpc.ServerStream
+}
+
+type serviceGetBlockRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceGetBlockRangeServer) Send(m *GetBlockRangeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.cosmos.forwarder.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _Service_GetNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetSyncing",
+			Handler:    _Service_GetSyncing_Handler,
+		},
+		{
+			MethodName: "GetLatestBlock",
+			Handler:    _Service_GetLatestBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockByHeight",
+			Handler:    _Service_GetBlockByHeight_Handler,
+		},
+		{
+			MethodName: "GetLatestValidatorSet",
+			Handler:    _Service_GetLatestValidatorSet_Handler,
+		},
+		{
+			MethodName: "GetValidatorSetByHeight",
+			Handler:    _Service_GetValidatorSetByHeight_Handler,
+		},
+		{
+			MethodName: "ABCIQuery",
+			Handler:    _Service_ABCIQuery_Handler,
+		},
+		{
+			MethodName: "GetDecodedBlockByHeight",
+			Handler:    _Service_GetDecodedBlockByHeight_Handler,
+		},
+		{
+			MethodName: "GetFullValidatorSet",
+			Handler:    _Service_GetFullValidatorSet_Handler,
+		},
+		{
+			MethodName: "GetValidatorSetDiff",
+			Handler:    _Service_GetValidatorSetDiff_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _Service_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetTxByHash",
+			Handler:    _Service_GetTxByHash_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamValidatorSet",
+			Handler:       _Service_StreamValidatorSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetBlockRange",
+			Handler:       _Service_GetBlockRange_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/cosmos/forwarder/v1/query.proto",
+}
+
+func (m *GetValidatorSetByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorSetByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProofOps) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorSetByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorSetByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Ops) > 0 {
-		for _, e := range m.Ops {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Concurrency != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Concurrency))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SdkBlock != nil {
+		{
+			size, err := m.SdkBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFullValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFullValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFullValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PageSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFullValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFullValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFullValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorSetDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorSetDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorSetDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorSetDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changed) > 0 {
+		for iNdEx := len(m.Changed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Added[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposerPriorityDelta != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposerPriorityDelta))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ToProposerPriority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToProposerPriority))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FromProposerPriority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromProposerPriority))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotingPowerDelta != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPowerDelta))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ToVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposerPriority != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposerPriority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SdkBlock != nil {
+		{
+			size, err := m.SdkBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDecodedBlockByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDecodedBlockByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDecodedBlockByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDecodedBlockByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDecodedBlockByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDecodedBlockByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SdkBlock != nil {
+		{
+			size, err := m.SdkBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DecodeError) > 0 {
+		i -= len(m.DecodeError)
+		copy(dAtA[i:], m.DecodeError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DecodeError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SignerInfos) > 0 {
+		for iNdEx := len(m.SignerInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Fee != nil {
+		{
+			size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SdkBlock != nil {
+		{
+			size, err := m.SdkBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSyncingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSyncingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSyncingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSyncingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSyncingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSyncingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNodeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationVersion != nil {
+		{
+			size, err := m.ApplicationVersion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DefaultNodeInfo != nil {
+		{
+			size, err := m.DefaultNodeInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmosSdkVersion) > 0 {
+		i -= len(m.CosmosSdkVersion)
+		copy(dAtA[i:], m.CosmosSdkVersion)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosSdkVersion)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BuildDeps) > 0 {
+		for iNdEx := len(m.BuildDeps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuildDeps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GoVersion) > 0 {
+		i -= len(m.GoVersion)
+		copy(dAtA[i:], m.GoVersion)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GoVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BuildTags) > 0 {
+		i -= len(m.BuildTags)
+		copy(dAtA[i:], m.BuildTags)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BuildTags)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GitCommit) > 0 {
+		i -= len(m.GitCommit)
+		copy(dAtA[i:], m.GitCommit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GitCommit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppName) > 0 {
+		i -= len(m.AppName)
+		copy(dAtA[i:], m.AppName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AppName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Module) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Module) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sum) > 0 {
+		i -= len(m.Sum)
+		copy(dAtA[i:], m.Sum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ABCIQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABCIQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABCIQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prove {
+		i--
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ABCIQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABCIQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABCIQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ProofOps != nil {
+		{
+			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofOps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofOps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetValidatorSetByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetValidatorSetByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TxHashes) > 0 {
+		for _, s := range m.TxHashes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetBlockRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovQuery(uint64(m.Concurrency))
+	}
+	return n
+}
+
+func (m *GetBlockRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SdkBlock != nil {
+		l = m.SdkBlock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFullValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovQuery(uint64(m.PageSize))
+	}
+	return n
+}
+
+func (m *GetFullValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StreamValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetValidatorSetDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *GetValidatorSetDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if len(m.Added) > 0 {
+		for _, e := range m.Added {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Changed) > 0 {
+		for _, e := range m.Changed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.FromVotingPower))
+	}
+	if m.ToVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.ToVotingPower))
+	}
+	if m.VotingPowerDelta != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPowerDelta))
+	}
+	if m.FromProposerPriority != 0 {
+		n += 1 + sovQuery(uint64(m.FromProposerPriority))
+	}
+	if m.ToProposerPriority != 0 {
+		n += 1 + sovQuery(uint64(m.ToProposerPriority))
+	}
+	if m.ProposerPriorityDelta != 0 {
+		n += 1 + sovQuery(uint64(m.ProposerPriorityDelta))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ProposerPriority != 0 {
+		n += 1 + sovQuery(uint64(m.ProposerPriority))
+	}
+	return n
+}
+
+func (m *GetBlockByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SdkBlock != nil {
+		l = m.SdkBlock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDecodedBlockByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetDecodedBlockByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SdkBlock != nil {
+		l = m.SdkBlock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecodedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutHeight))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SignerInfos) > 0 {
+		for _, e := range m.SignerInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.DecodeError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLatestBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SdkBlock != nil {
+		l = m.SdkBlock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSyncingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSyncingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetNodeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultNodeInfo != nil {
+		l = m.DefaultNodeInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ApplicationVersion != nil {
+		l = m.ApplicationVersion.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AppName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GitCommit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BuildTags)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GoVersion)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.BuildDeps) > 0 {
+		for _, e := range m.BuildDeps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.CosmosSdkVersion)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Module) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ABCIQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Prove {
+		n += 2
+	}
+	return n
+}
+
+func (m *ABCIQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofOps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockByHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockByHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &IndexedBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxByHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxByHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &tmservice.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SdkBlock == nil {
+				m.SdkBlock = &Block{}
+			}
+			if err := m.SdkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFullValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFullValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFullValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFullValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFullValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFullValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorSetDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorSetDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorSetDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorSetDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, &Validator{})
+			if err := m.Added[len(m.Added)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, &Validator{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changed = append(m.Changed, &ValidatorChange{})
+			if err := m.Changed[len(m.Changed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVotingPower", wireType)
+			}
+			m.FromVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVotingPower", wireType)
+			}
+			m.ToVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerDelta", wireType)
+			}
+			m.VotingPowerDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromProposerPriority", wireType)
+			}
+			m.FromProposerPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromProposerPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToProposerPriority", wireType)
+			}
+			m.ToProposerPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToProposerPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerPriorityDelta", wireType)
+			}
+			m.ProposerPriorityDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerPriorityDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2893,17 +7508,17 @@ func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2913,14 +7528,27 @@ func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2947,13 +7575,51 @@ func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.PubKey == nil {
+				m.PubKey = &types1.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerPriority", wireType)
+			}
+			m.ProposerPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2975,7 +7641,7 @@ func (m *GetValidatorSetByHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *GetBlockByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,17 +7664,17 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBlockByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorSetByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBlockByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.BlockHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3018,14 +7684,100 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3052,14 +7804,16 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Block == nil {
+				m.Block = &types.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3086,10 +7840,10 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.SdkBlock == nil {
+				m.SdkBlock = &Block{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SdkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3114,7 +7868,7 @@ func (m *GetValidatorSetByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDecodedBlockByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3137,17 +7891,17 @@ func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDecodedBlockByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDecodedBlockByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3157,28 +7911,11 @@ func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3200,7 +7937,7 @@ func (m *GetLatestValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDecodedBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3223,17 +7960,17 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDecodedBlockByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDecodedBlockByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3243,14 +7980,31 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3277,14 +8031,16 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SdkBlock == nil {
+				m.SdkBlock = &Block{}
+			}
+			if err := m.SdkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3311,10 +8067,8 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, &DecodedTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3339,7 +8093,7 @@ func (m *GetLatestValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *DecodedTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3362,15 +8116,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecodedTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecodedTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3398,11 +8152,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3429,18 +8183,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubKey == nil {
-				m.PubKey = &types.Any{}
-			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			m.VotingPower = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3450,16 +8202,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerPriority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
 			}
-			m.ProposerPriority = 0
+			m.TimeoutHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3469,66 +8234,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposerPriority |= int64(b&0x7F) << shift
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetBlockByHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockByHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3538,64 +8253,31 @@ func (m *GetBlockByHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Fee == nil {
+				m.Fee = &tx.Fee{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockByHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3622,18 +8304,16 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockId == nil {
-				m.BlockId = &types1.BlockID{}
-			}
-			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignerInfos = append(m.SignerInfos, &tx.SignerInfo{})
+			if err := m.SignerInfos[len(m.SignerInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3643,33 +8323,29 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &types1.Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SdkBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3679,27 +8355,23 @@ func (m *GetBlockByHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SdkBlock == nil {
-				m.SdkBlock = &Block{}
-			}
-			if err := m.SdkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DecodeError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3831,7 +8503,7 @@ func (m *GetLatestBlockResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.BlockId == nil {
-				m.BlockId = &types1.BlockID{}
+				m.BlockId = &types.BlockID{}
 			}
 			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3867,7 +8539,7 @@ func (m *GetLatestBlockResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Block == nil {
-				m.Block = &types1.Block{}
+				m.Block = &types.Block{}
 			}
 			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
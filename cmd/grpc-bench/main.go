@@ -0,0 +1,217 @@
+// Command grpc-bench drives a mix of RPCs against a forwarder at a target rate or concurrency and
+// reports latency percentiles, throughput and errors by gRPC status code.
+//
+// Examples:
+//
+//	grpc-bench -target localhost:8080 -rps 200 -concurrency 32 -duration 1m -heights 8658000:8658239
+//	grpc-bench -in-process -fake-latency 20ms -concurrency 64 -format json
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/bench"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// _defaultHeightWindow is the number of most recent blocks requested when no heights are given.
+const _defaultHeightWindow = 100
+
+type options struct {
+	target      string
+	chainID     string
+	tls         client.TLSConfig
+	mix         string
+	rps         float64
+	concurrency int
+	duration    time.Duration
+	timeout     time.Duration
+	heights     string
+	seed        int64
+	format      string
+
+	inProcess     bool
+	fakeHeight    int64
+	fakeValidator int
+	fakeLatency   time.Duration
+}
+
+func main() {
+	var opts options
+
+	flag.StringVar(&opts.target, "target", "localhost:8080", "forwarder address as host:port")
+	flag.StringVar(&opts.chainID, "chain-id", "", "chain to query, sent as the "+upstream.ChainIDHeader+" header")
+	flag.BoolVar(&opts.tls.Enabled, "tls", false, "connect over TLS")
+	flag.StringVar(&opts.tls.CACertFile, "tls-ca-cert", "", "PEM file with the CAs to verify the server with")
+	flag.StringVar(&opts.tls.CertFile, "tls-cert", "", "PEM client certificate for mutual TLS")
+	flag.StringVar(&opts.tls.KeyFile, "tls-key", "", "PEM client key for mutual TLS")
+	flag.StringVar(&opts.tls.ServerName, "tls-server-name", "", "server name to verify the certificate against")
+	flag.BoolVar(&opts.tls.InsecureSkipVerify, "tls-insecure-skip-verify", false, "do not verify the server certificate")
+	flag.StringVar(&opts.mix, "mix", bench.DefaultMix,
+		"weighted RPCs as rpc=weight,...; one of "+strings.Join(bench.RPCs(), ", "))
+	flag.Float64Var(&opts.rps, "rps", 0, "target requests per second; 0 sends requests back to back")
+	flag.IntVar(&opts.concurrency, "concurrency", 16, "number of workers, i.e. maximum requests in flight")
+	flag.DurationVar(&opts.duration, "duration", 30*time.Second, "duration of the run")
+	flag.DurationVar(&opts.timeout, "timeout", 10*time.Second, "timeout of each request")
+	flag.StringVar(&opts.heights, "heights", "", "inclusive height range from:to of height RPCs (default the latest 100 blocks)")
+	flag.Int64Var(&opts.seed, "seed", 1, "seed of the RPC and height sequence")
+	flag.StringVar(&opts.format, "format", "text", "report format: text or json")
+	flag.BoolVar(&opts.inProcess, "in-process", false, "benchmark an in-process forwarder in front of a fake upstream")
+	flag.Int64Var(&opts.fakeHeight, "fake-height", 10000, "latest height of the fake upstream chain")
+	flag.IntVar(&opts.fakeValidator, "fake-validators", 150, "number of validators of the fake upstream chain")
+	flag.DurationVar(&opts.fakeLatency, "fake-latency", 0, "latency the fake upstream adds to every call")
+	flag.Parse()
+
+	if err := run(context.Background(), opts, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, opts options, stdout, stderr io.Writer) error {
+	if opts.format != "text" && opts.format != "json" {
+		return errors.Errorf("invalid format %q, expected text or json", opts.format)
+	}
+
+	mix, err := bench.ParseMix(opts.mix)
+	if err != nil {
+		return err
+	}
+
+	serviceClient, closer, err := newServiceClient(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	defer closer()
+
+	if opts.chainID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, upstream.ChainIDHeader, opts.chainID)
+	}
+
+	minHeight, maxHeight, err := heightRange(ctx, serviceClient, opts)
+	if err != nil {
+		return err
+	}
+
+	config := bench.Config{
+		Mix:         mix,
+		Duration:    opts.duration,
+		Concurrency: opts.concurrency,
+		RPS:         opts.rps,
+		MinHeight:   minHeight,
+		MaxHeight:   maxHeight,
+		Timeout:     opts.timeout,
+		Seed:        opts.seed,
+	}
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stderr, "Running %s against %s at heights %d:%d...\n", opts.duration, describeTarget(opts), minHeight, maxHeight)
+
+	report, err := bench.Run(ctx, serviceClient, config)
+	if err != nil {
+		return err
+	}
+
+	if opts.format == "json" {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(report)
+	}
+
+	return report.WriteText(stdout)
+}
+
+func describeTarget(opts options) string {
+	if opts.inProcess {
+		return "an in-process forwarder"
+	}
+
+	return opts.target
+}
+
+func newServiceClient(ctx context.Context, opts options) (pb.ServiceClient, func(), error) {
+	if opts.inProcess {
+		serviceClient, _, closer, err := bench.NewInProcessClient(ctx, bench.InProcessConfig{
+			ChainID:      "bench-1",
+			LatestHeight: opts.fakeHeight,
+			Validators:   opts.fakeValidator,
+			Latency:      opts.fakeLatency,
+		})
+
+		return serviceClient, closer, err
+	}
+
+	creds, err := opts.tls.TransportCredentials()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := client.NewGRPCConn(ctx, opts.target, nil,
+		grpc.WithTransportCredentials(creds),
+		// The interface registry unpacks google.protobuf.Any values, like validator public keys.
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(
+			codec.NewProtoCodec(registry.InitializeInterfaceRegistry()).GRPCCodec(),
+		)),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "cannot connect to %s", opts.target)
+	}
+
+	//nolint:errcheck
+	return pb.NewServiceClient(conn), func() { conn.Close() }, nil
+}
+
+// heightRange parses the -heights flag, or asks the forwarder for its latest height and returns
+// the window of the most recent blocks below it.
+func heightRange(ctx context.Context, serviceClient pb.ServiceClient, opts options) (int64, int64, error) {
+	if opts.heights != "" {
+		fromStr, toStr, found := strings.Cut(opts.heights, ":")
+
+		from, errFrom := strconv.ParseInt(fromStr, 10, 64)
+		to, errTo := strconv.ParseInt(toStr, 10, 64)
+
+		if !found || errFrom != nil || errTo != nil {
+			return 0, 0, errors.Errorf("invalid height range %q, expected from:to", opts.heights)
+		}
+
+		return from, to, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	latest, err := serviceClient.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{})
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "cannot get the latest height")
+	}
+
+	to := latest.GetSdkBlock().GetHeader().Height
+
+	from := to - _defaultHeightWindow + 1
+	if from < 1 {
+		from = 1
+	}
+
+	return from, to, nil
+}
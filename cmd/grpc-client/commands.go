@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+// callFunc calls the forwarder and returns the response to print.
+type callFunc func(ctx context.Context, serviceClient pb.ServiceClient) (proto.Message, error)
+
+// command parses the arguments of a subcommand into a call.
+type command func(args []string) (callFunc, error)
+
+var _commands = map[string]command{
+	"node-info":  nodeInfoCommand,
+	"syncing":    syncingCommand,
+	"block":      blockCommand,
+	"validators": validatorsCommand,
+	"abci-query": abciQueryCommand,
+}
+
+func nodeInfoCommand(args []string) (callFunc, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("unexpected arguments %v", args)
+	}
+
+	return func(ctx context.Context, serviceClient pb.ServiceClient) (proto.Message, error) {
+		return serviceClient.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{})
+	}, nil
+}
+
+func syncingCommand(args []string) (callFunc, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("unexpected arguments %v", args)
+	}
+
+	return func(ctx context.Context, serviceClient pb.ServiceClient) (proto.Message, error) {
+		return serviceClient.GetSyncing(ctx, &pb.GetSyncingRequest{})
+	}, nil
+}
+
+func blockCommand(args []string) (callFunc, error) {
+	height, err := parseHeightArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, serviceClient pb.ServiceClient) (proto.Message, error) {
+		if height == 0 {
+			return serviceClient.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{})
+		}
+
+		return serviceClient.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: height})
+	}, nil
+}
+
+func validatorsCommand(args []string) (callFunc, error) {
+	height, err := parseHeightArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, serviceClient pb.ServiceClient) (proto.Message, error) {
+		return serviceClient.GetFullValidatorSet(ctx, &pb.GetFullValidatorSetRequest{Height: height})
+	}, nil
+}
+
+func abciQueryCommand(args []string) (callFunc, error) {
+	req := &pb.ABCIQueryRequest{}
+
+	fs := flag.NewFlagSet("abci-query", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Int64Var(&req.Height, "height", 0, "height to query at, the latest one by default")
+	fs.BoolVar(&req.Prove, "prove", false, "include a proof of the result")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	switch fs.NArg() {
+	case 2:
+		data, err := hex.DecodeString(strings.TrimPrefix(fs.Arg(1), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex data %q", fs.Arg(1))
+		}
+
+		req.Data = data
+
+		fallthrough
+	case 1:
+		req.Path = fs.Arg(0)
+	default:
+		return nil, fmt.Errorf("expected <path> [data], got %v", fs.Args())
+	}
+
+	return func(ctx context.Context, serviceClient pb.ServiceClient) (proto.Message, error) {
+		return serviceClient.ABCIQuery(ctx, req)
+	}, nil
+}
+
+// parseHeightArg parses an optional "latest" or height argument. Zero means the latest height.
+func parseHeightArg(args []string) (int64, error) {
+	switch {
+	case len(args) == 0 || len(args) == 1 && args[0] == "latest":
+		return 0, nil
+	case len(args) > 1:
+		return 0, fmt.Errorf("unexpected arguments %v", args[1:])
+	}
+
+	height, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || height <= 0 {
+		return 0, fmt.Errorf("invalid height %q, expected latest or a positive number", args[0])
+	}
+
+	return height, nil
+}
@@ -1,92 +1,243 @@
+// Command grpc-client calls the forwarder's gRPC API and prints the responses.
+//
+// Usage:
+//
+//	grpc-client [flags] <command> [arguments]
+//
+// Commands:
+//
+//	node-info                        Print the node and application versions.
+//	syncing                          Print whether the node is catching up.
+//	block [latest|<height>]          Print a block, the latest one by default.
+//	validators [latest|<height>]     Print the full validator-set, the latest one by default.
+//	abci-query [flags] <path> [data] Run an ABCI query with hex encoded data.
+//
+// Failed calls print the gRPC status and exit with status 1, like connection errors; invalid usage
+// exits with status 2.
 package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"io"
+	"os"
+	"time"
 
-	"github.com/cosmos/cosmos-sdk/types/query"
-
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
-	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
-
-	"github.com/joho/godotenv"
 	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
-	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+const (
+	_exitError = 1
+	_exitUsage = 2
 )
 
+// dialFunc connects to the target.
+type dialFunc func(ctx context.Context, target string, interceptors []grpc.UnaryClientInterceptor,
+	opts ...grpc.DialOption) (grpc.ClientConnInterface, error)
+
+type options struct {
+	target   string
+	chainID  string
+	timeout  time.Duration
+	output   string
+	jsonMode string
+	verbose  bool
+	tls      client.TLSConfig
+}
+
+// app runs a single command line.
+type app struct {
+	stdout io.Writer
+	stderr io.Writer
+	dial   dialFunc
+}
+
 func main() {
-	var err error
+	// The target defaults to the server address in .env.dist when there is one.
+	//nolint:errcheck
+	godotenv.Load(".env.dist")
+
+	a := &app{
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		dial: func(ctx context.Context, target string, interceptors []grpc.UnaryClientInterceptor,
+			opts ...grpc.DialOption) (grpc.ClientConnInterface, error) {
+			return client.NewGRPCConn(ctx, target, interceptors, opts...)
+		},
+	}
 
-	ctx := context.Background()
+	os.Exit(a.run(context.Background(), os.Args[1:]))
+}
 
-	err = godotenv.Load(".env.dist")
-	if err != nil {
-		panic(err)
+func defaultTarget() string {
+	host, port := os.Getenv("SERVER_HOST"), os.Getenv("SERVER_PORT")
+	if host == "" || port == "" {
+		return "localhost:8080"
 	}
 
-	conf := configs.InitializeConfig()
+	return host + ":" + port
+}
+
+func (a *app) flagSet() (*flag.FlagSet, *options) {
+	var opts options
+
+	fs := flag.NewFlagSet("grpc-client", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() {
+		fmt.Fprint(a.stderr, _usage)
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&opts.target, "target", defaultTarget(), "forwarder address as host:port")
+	fs.StringVar(&opts.chainID, "chain-id", "", "chain to query, sent as the "+upstream.ChainIDHeader+" header")
+	fs.DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout of the call")
+	fs.StringVar(&opts.output, "output", "json", "output format: json, yaml or table")
+	fs.StringVar(&opts.jsonMode, "json-mode", "proto3", "JSON mode of the json and yaml output")
+	fs.BoolVar(&opts.verbose, "v", false, "log requests and responses to stderr")
+	fs.BoolVar(&opts.tls.Enabled, "tls", false, "connect over TLS")
+	fs.StringVar(&opts.tls.CACertFile, "tls-ca-cert", "", "PEM file with the CAs to verify the server with")
+	fs.StringVar(&opts.tls.CertFile, "tls-cert", "", "PEM client certificate for mutual TLS")
+	fs.StringVar(&opts.tls.KeyFile, "tls-key", "", "PEM client key for mutual TLS")
+	fs.StringVar(&opts.tls.ServerName, "tls-server-name", "", "server name to verify the certificate against")
+	fs.BoolVar(&opts.tls.InsecureSkipVerify, "tls-insecure-skip-verify", false, "do not verify the server certificate")
+
+	return fs, &opts
+}
+
+const _usage = `Usage: grpc-client [flags] <command> [arguments]
 
-	logger := log.InitializeLogger(conf.LogLevel, conf.LogFormat)
+Commands:
+  node-info                        Print the node and application versions.
+  syncing                          Print whether the node is catching up.
+  block [latest|<height>]          Print a block, the latest one by default.
+  validators [latest|<height>]     Print the full validator-set, the latest one by default.
+  abci-query [flags] <path> [data] Run an ABCI query with hex encoded data.
 
-	jsonConverter := jsonconv.NewJSONConverter()
+Flags:
+`
 
-	forwarderClient := getGPRCClient(ctx, logger, conf, jsonConverter)
+func (a *app) run(ctx context.Context, args []string) int {
+	fs, opts := a.flagSet()
 
-	if _, err = forwarderClient.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{}); err != nil {
-		handleResponseError(err, logger)
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+
+		return _exitUsage
 	}
 
-	if _, err = forwarderClient.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{
-		Height: 8658239,
-	}); err != nil {
-		handleResponseError(err, logger)
+	if fs.NArg() == 0 {
+		fs.Usage()
+
+		return _exitUsage
 	}
 
-	if _, err = forwarderClient.GetSyncing(ctx, &pb.GetSyncingRequest{}); err != nil {
-		handleResponseError(err, logger)
+	cmd, ok := _commands[fs.Arg(0)]
+	if !ok {
+		fmt.Fprintf(a.stderr, "error: unknown command %q\n", fs.Arg(0))
+		fs.Usage()
+
+		return _exitUsage
 	}
 
-	if _, err = forwarderClient.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{}); err != nil {
-		handleResponseError(err, logger)
+	call, err := cmd(fs.Args()[1:])
+	if err != nil {
+		fmt.Fprintf(a.stderr, "error: %s: %v\n", fs.Arg(0), err)
+
+		return _exitUsage
 	}
 
-	if _, err = forwarderClient.GetValidatorSetByHeight(ctx, &pb.GetValidatorSetByHeightRequest{
-		Height:     8658239,
-		Pagination: &query.PageRequest{},
-	}); err != nil {
-		handleResponseError(err, logger)
+	printer, err := a.newPrinter(opts)
+	if err != nil {
+		fmt.Fprintln(a.stderr, "error:", err)
+
+		return _exitUsage
 	}
-}
 
-func getGPRCClient(
-	ctx context.Context,
-	logger log.Logger,
-	conf *configs.Config,
-	jsonConverter *jsonconv.JSONConverter,
-) pb.ServiceClient {
-	conn, err := client.NewDefaultGRPCConn(
-		ctx,
-		logger,
-		jsonConverter,
-		fmt.Sprintf("%s:%d", conf.ServerHost, conf.ServerPort),
-	)
+	creds, err := opts.tls.TransportCredentials()
+	if err != nil {
+		fmt.Fprintln(a.stderr, "error:", err)
+
+		return _exitUsage
+	}
+
+	serviceClient, err := a.newServiceClient(ctx, opts, creds)
+	if err != nil {
+		fmt.Fprintln(a.stderr, "error:", err)
+
+		return _exitError
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
 
+	if opts.chainID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, upstream.ChainIDHeader, opts.chainID)
+	}
+
+	resp, err := call(ctx, serviceClient)
 	if err != nil {
-		logger.Fatal("error: cannot create a gRPC connection: ", log.Error(err))
+		st := status.Convert(err)
+		fmt.Fprintf(a.stderr, "error: code = %s desc = %s\n", st.Code(), st.Message())
+
+		return _exitError
 	}
 
-	return pb.NewServiceClient(conn)
+	if err := printer.Print(a.stdout, resp); err != nil {
+		fmt.Fprintln(a.stderr, "error:", err)
+
+		return _exitError
+	}
+
+	return 0
 }
 
-func handleResponseError(err error, logger log.Logger) {
+func (a *app) newPrinter(opts *options) (*printer, error) {
+	mode, err := jsonconv.ParseMode(opts.jsonMode)
 	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			logger.Panic(fmt.Sprintf("gRPC Code: %s, response error message: %s", e.Code(), e.Message()))
-		}
+		return nil, err
+	}
+
+	return newPrinter(opts.output, jsonconv.NewJSONConverter(
+		jsonconv.WithMode(mode),
+		jsonconv.WithInterfaceRegistry(registry.InitializeInterfaceRegistry()),
+	))
+}
 
-		logger.Panic("raw response error: ", log.Error(err))
+func (a *app) newServiceClient(
+	ctx context.Context, opts *options, creds credentials.TransportCredentials) (pb.ServiceClient, error) {
+	interfaceRegistry := registry.InitializeInterfaceRegistry()
+
+	interceptors := []grpc.UnaryClientInterceptor{client.NewRequestIDInterceptor()}
+
+	if opts.verbose {
+		logger := log.New(log.WithLogToStdout(false), log.WithOutput(a.stderr), log.WithLevel(log.DebugLevel))
+		interceptors = append(interceptors,
+			client.NewLoggingInterceptor(logger, jsonconv.NewJSONConverter(jsonconv.WithInterfaceRegistry(interfaceRegistry))))
 	}
+
+	conn, err := a.dial(ctx, opts.target, interceptors,
+		grpc.WithTransportCredentials(creds),
+		// The interface registry unpacks google.protobuf.Any values, like validator public keys.
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(interfaceRegistry).GRPCCodec())),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to %s: %w", opts.target, err)
+	}
+
+	return pb.NewServiceClient(conn), nil
 }
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"google.golang.org/grpc"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// newTestApp returns an app whose connections go to a forwarder in front of fake upstreams
+// of the chains test-1, the default, and test-2.
+func newTestApp(t *testing.T) (*app, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+
+	logger := log.InitializeLogger("error", "json")
+	config := testrunner.NewDefaultTestConfig(logger, &configs.Config{DefaultChainID: "test-1"}, jsonconv.NewJSONConverter())
+	config.Upstreams = []*testrunner.FakeUpstream{
+		testrunner.NewFakeUpstream("test-1", 100, 3),
+		testrunner.NewFakeUpstream("test-2", 200, 3),
+	}
+
+	conn, closer, err := testrunner.NewUnaryTestSetup(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(closer)
+
+	var stdout, stderr bytes.Buffer
+
+	return &app{
+		stdout: &stdout,
+		stderr: &stderr,
+		dial: func(context.Context, string, []grpc.UnaryClientInterceptor, ...grpc.DialOption) (grpc.ClientConnInterface, error) {
+			return conn, nil
+		},
+	}, &stdout, &stderr
+}
+
+func TestCommands(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "node info table",
+			args: []string{"-output", "table", "node-info"},
+			want: []string{"NETWORK             test-1", "COSMOS SDK VERSION  v0.47.2"},
+		},
+		{
+			name: "node info of another chain",
+			args: []string{"-chain-id", "test-2", "node-info"},
+			want: []string{`"network":"test-2"`},
+		},
+		{
+			name: "syncing",
+			args: []string{"syncing"},
+			want: []string{`"syncing":false`},
+		},
+		{
+			name: "latest block table",
+			args: []string{"-output", "table", "block", "latest"},
+			want: []string{"HEIGHT    100", "TXS       0"},
+		},
+		{
+			name: "block by height yaml",
+			args: []string{"-output", "yaml", "block", "42"},
+			want: []string{`height: "42"`, "chain_id: test-1"},
+		},
+		{
+			name: "validators table",
+			args: []string{"-output", "table", "validators", "50"},
+			want: []string{"BLOCK HEIGHT  50", "ADDRESS", "3000"},
+		},
+		{
+			name: "abci query",
+			args: []string{"-output", "table", "abci-query", "-height", "7", "/store/bank/key", "0xcafe"},
+			want: []string{"HEIGHT     7", "VALUE      cafe"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, stdout, stderr := newTestApp(t)
+
+			if code := a.run(context.Background(), tc.args); code != 0 {
+				t.Fatalf("expected exit code 0, got %d: %s", code, stderr)
+			}
+
+			for _, want := range tc.want {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, stdout)
+				}
+			}
+		})
+	}
+}
+
+func TestCommandErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		wantCode int
+		want     string
+	}{
+		{name: "rpc error", args: []string{"block", "101"}, wantCode: _exitError, want: "code = InvalidArgument"},
+		{name: "no command", args: nil, wantCode: _exitUsage, want: "Usage: grpc-client"},
+		{name: "unknown command", args: []string{"blocks"}, wantCode: _exitUsage, want: `unknown command "blocks"`},
+		{name: "invalid height", args: []string{"block", "-1"}, wantCode: _exitUsage, want: "invalid height"},
+		{name: "invalid data", args: []string{"abci-query", "/p", "xyz"}, wantCode: _exitUsage, want: "invalid hex data"},
+		{name: "invalid output", args: []string{"-output", "xml", "syncing"}, wantCode: _exitUsage, want: "invalid output format"},
+		{
+			name:     "missing CA certificate",
+			args:     []string{"-tls", "-tls-ca-cert", "missing.pem", "syncing"},
+			wantCode: _exitUsage,
+			want:     "cannot read CA certificate",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, _, stderr := newTestApp(t)
+
+			if code := a.run(context.Background(), tc.args); code != tc.wantCode {
+				t.Errorf("expected exit code %d, got %d", tc.wantCode, code)
+			}
+
+			if !strings.Contains(stderr.String(), tc.want) {
+				t.Errorf("expected stderr to contain %q, got:\n%s", tc.want, stderr)
+			}
+		})
+	}
+}
+
+func TestCommandConnectionError(t *testing.T) {
+	a, _, stderr := newTestApp(t)
+	a.dial = func(context.Context, string, []grpc.UnaryClientInterceptor, ...grpc.DialOption) (grpc.ClientConnInterface, error) {
+		return nil, errors.New("connection refused")
+	}
+
+	if code := a.run(context.Background(), []string{"syncing"}); code != _exitError {
+		t.Errorf("expected exit code %d, got %d", _exitError, code)
+	}
+
+	if !strings.Contains(stderr.String(), "cannot connect to") {
+		t.Errorf("expected a connection error, got:\n%s", stderr)
+	}
+}
+
+func TestPrintTableBlockWithoutSDKBlock(t *testing.T) {
+	fake := testrunner.NewFakeUpstream("test-1", 10, 3)
+	fake.SetTxs(7, []byte("tx-1"), []byte("tx-2"))
+
+	upstreamBlock, err := fake.GetBlockByHeight(context.Background(), &tmservice.GetBlockByHeightRequest{Height: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+
+	err = printTable(&out, &pb.GetBlockByHeightResponse{BlockId: upstreamBlock.BlockId, Block: upstreamBlock.Block})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"CHAIN ID  test-1",
+		"HEIGHT    7",
+		"PROPOSER  " + upstreamBlock.SdkBlock.Header.ProposerAddress,
+		"TXS       2",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
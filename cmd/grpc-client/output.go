@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"sigs.k8s.io/yaml"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+)
+
+// printer writes responses in one of the output formats.
+type printer struct {
+	format        string
+	jsonConverter *jsonconv.JSONConverter
+}
+
+func newPrinter(format string, jsonConverter *jsonconv.JSONConverter) (*printer, error) {
+	switch format {
+	case "json", "yaml", "table":
+	default:
+		return nil, fmt.Errorf("invalid output format %q, expected json, yaml or table", format)
+	}
+
+	return &printer{format: format, jsonConverter: jsonConverter}, nil
+}
+
+// Print writes resp to w.
+func (p *printer) Print(w io.Writer, resp proto.Message) error {
+	if p.format == "table" {
+		return printTable(w, resp)
+	}
+
+	b, err := p.jsonConverter.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	if p.format == "yaml" {
+		if b, err = yaml.JSONToYAML(b); err != nil {
+			return err
+		}
+
+		_, err = w.Write(b)
+
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", b)
+
+	return err
+}
+
+// printTable writes the most relevant fields of resp as aligned columns.
+func printTable(w io.Writer, resp proto.Message) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	switch resp := resp.(type) {
+	case *pb.GetNodeInfoResponse:
+		nodeInfo, version := resp.GetDefaultNodeInfo(), resp.GetApplicationVersion()
+
+		printRows(tw, [][2]string{
+			{"NETWORK", nodeInfo.GetNetwork()},
+			{"MONIKER", nodeInfo.GetMoniker()},
+			{"NODE ID", nodeInfo.GetDefaultNodeID()},
+			{"COMETBFT VERSION", nodeInfo.GetVersion()},
+			{"APP NAME", version.GetAppName()},
+			{"APP VERSION", version.GetVersion()},
+			{"COSMOS SDK VERSION", version.GetCosmosSdkVersion()},
+			{"GO VERSION", version.GetGoVersion()},
+		})
+	case *pb.GetSyncingResponse:
+		printRows(tw, [][2]string{{"SYNCING", strconv.FormatBool(resp.GetSyncing())}})
+	case *pb.GetLatestBlockResponse:
+		printBlock(tw, resp.GetBlockId().GetHash(), resp.GetBlock(), resp.GetSdkBlock())
+	case *pb.GetBlockByHeightResponse:
+		printBlock(tw, resp.GetBlockId().GetHash(), resp.GetBlock(), resp.GetSdkBlock())
+	case *pb.GetFullValidatorSetResponse:
+		fmt.Fprintf(tw, "BLOCK HEIGHT\t%d\n\n", resp.GetBlockHeight())
+		fmt.Fprintln(tw, "ADDRESS\tVOTING POWER\tPROPOSER PRIORITY")
+
+		for _, v := range resp.GetValidators() {
+			fmt.Fprintf(tw, "%s\t%d\t%d\n", v.GetAddress(), v.GetVotingPower(), v.GetProposerPriority())
+		}
+	case *pb.ABCIQueryResponse:
+		printRows(tw, [][2]string{
+			{"CODE", strconv.FormatUint(uint64(resp.GetCode()), 10)},
+			{"CODESPACE", resp.GetCodespace()},
+			{"HEIGHT", strconv.FormatInt(resp.GetHeight(), 10)},
+			{"KEY", hex.EncodeToString(resp.GetKey())},
+			{"VALUE", hex.EncodeToString(resp.GetValue())},
+			{"LOG", resp.GetLog()},
+			{"INFO", resp.GetInfo()},
+		})
+	default:
+		return fmt.Errorf("no table output for %T", resp)
+	}
+
+	return tw.Flush()
+}
+
+// printBlock prints sdkBlock, or block for upstreams running a Cosmos SDK before v0.47,
+// which don't send sdk_block.
+func printBlock(w io.Writer, hash []byte, block *cmtproto.Block, sdkBlock *pb.Block) {
+	if sdkBlock == nil {
+		header := block.GetHeader()
+
+		printBlockRows(w, header.ChainID, header.Height, hash, header.Time,
+			sdk.ConsAddress(header.ProposerAddress).String(), len(block.GetData().Txs))
+
+		return
+	}
+
+	header := sdkBlock.GetHeader()
+
+	printBlockRows(w, header.ChainID, header.Height, hash, header.Time,
+		header.ProposerAddress, len(sdkBlock.GetData().Txs))
+}
+
+func printBlockRows(w io.Writer, chainID string, height int64, hash []byte, blockTime time.Time, proposer string, txs int) {
+	printRows(w, [][2]string{
+		{"CHAIN ID", chainID},
+		{"HEIGHT", strconv.FormatInt(height, 10)},
+		{"HASH", fmt.Sprintf("%X", hash)},
+		{"TIME", blockTime.UTC().Format(time.RFC3339Nano)},
+		{"PROPOSER", proposer},
+		{"TXS", strconv.Itoa(txs)},
+	})
+}
+
+func printRows(w io.Writer, rows [][2]string) {
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%s\n", row[0], row[1])
+	}
+}
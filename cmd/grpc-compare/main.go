@@ -0,0 +1,263 @@
+// Command grpc-compare runs the same RPCs against two or more gRPC endpoints, forwarders or Cosmos SDK
+// nodes, and prints how their JSON responses differ from the first endpoint's. It exits with status 1
+// on any mismatch or failed call and 2 on invalid usage. Calls failing on an unreachable endpoint
+// are reported and the remaining calls are still compared.
+//
+// Example:
+//
+//	grpc-compare -endpoint cosmos=grpc.osmosis.zone:9090 -endpoint forwarder=localhost:8080 \
+//		-rpcs GetNodeInfo,GetBlockByHeight -range 8658230:8658239
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/compare"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+const (
+	_exitMismatch = 1
+	_exitUsage    = 2
+)
+
+// stringsFlag is a repeatable string flag.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+
+	return nil
+}
+
+type options struct {
+	endpoints   stringsFlag
+	ignore      stringsFlag
+	rpcs        string
+	heights     string
+	heightRange string
+	format      string
+	jsonMode    string
+	timeout     time.Duration
+}
+
+func main() {
+	var opts options
+
+	flag.Var(&opts.endpoints, "endpoint",
+		"endpoint to compare as [forwarder=|cosmos=]host:port, repeatable; the first one is the baseline")
+	flag.Var(&opts.ignore, "ignore", "JSON path whose differences are ignored, e.g. default_node_info.moniker, repeatable")
+	flag.StringVar(&opts.rpcs, "rpcs", "", "comma-separated RPCs to compare (default all, or all without a height if no heights are given)")
+	flag.StringVar(&opts.heights, "heights", "", "comma-separated heights for RPCs which take a height")
+	flag.StringVar(&opts.heightRange, "range", "", "inclusive height range from:to for RPCs which take a height")
+	flag.StringVar(&opts.format, "format", "text", "output format: text or json")
+	flag.StringVar(&opts.jsonMode, "json-mode", "proto3", "JSON mode responses are rendered in before comparing")
+	flag.DurationVar(&opts.timeout, "timeout", 30*time.Second, "timeout of each RPC call")
+	flag.Parse()
+
+	os.Exit(run(context.Background(), opts, os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, opts options, stdout, stderr io.Writer) int {
+	calls, err := parseCalls(opts)
+	if err != nil {
+		fmt.Fprintln(stderr, "error:", err)
+
+		return _exitUsage
+	}
+
+	if opts.format != "text" && opts.format != "json" {
+		fmt.Fprintf(stderr, "error: invalid format %q, expected text or json\n", opts.format)
+
+		return _exitUsage
+	}
+
+	mode, err := jsonconv.ParseMode(opts.jsonMode)
+	if err != nil {
+		fmt.Fprintln(stderr, "error:", err)
+
+		return _exitUsage
+	}
+
+	interfaceRegistry := registry.InitializeInterfaceRegistry()
+	jsonConverter := jsonconv.NewJSONConverter(jsonconv.WithMode(mode), jsonconv.WithInterfaceRegistry(interfaceRegistry))
+
+	endpoints := make([]*compare.Endpoint, 0, len(opts.endpoints))
+
+	for _, spec := range opts.endpoints {
+		kind, addr, err := compare.ParseEndpoint(spec)
+		if err != nil {
+			fmt.Fprintln(stderr, "error:", err)
+
+			return _exitUsage
+		}
+
+		conn, err := client.NewGRPCConn(ctx, addr, nil,
+			// The Cosmos SDK doesn't support any transport security mechanism.
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(interfaceRegistry).GRPCCodec())),
+		)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: cannot connect to %s: %v\n", addr, err)
+
+			return _exitUsage
+		}
+
+		//nolint:errcheck
+		defer conn.Close()
+
+		endpoints = append(endpoints, compare.NewEndpoint(addr, kind, conn))
+	}
+
+	comparer, err := compare.NewComparer(jsonConverter, opts.ignore, endpoints...)
+	if err != nil {
+		fmt.Fprintln(stderr, "error:", err)
+
+		return _exitUsage
+	}
+
+	mismatches, failures := 0, 0
+	enc := json.NewEncoder(stdout)
+
+	for _, call := range calls {
+		callCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+		results := comparer.Compare(callCtx, call)
+
+		cancel()
+
+		for _, result := range results {
+			switch {
+			case result.Error != "":
+				failures++
+			case !result.Match():
+				mismatches++
+			}
+
+			if opts.format == "json" {
+				if err := enc.Encode(result); err != nil {
+					fmt.Fprintln(stderr, "error:", err)
+
+					return _exitUsage
+				}
+
+				continue
+			}
+
+			printResult(stdout, result)
+		}
+	}
+
+	if mismatches > 0 || failures > 0 {
+		fmt.Fprintf(stderr, "%d mismatched responses, %d failed calls\n", mismatches, failures)
+
+		return _exitMismatch
+	}
+
+	return 0
+}
+
+func printResult(w io.Writer, result compare.Result) {
+	if result.Error != "" {
+		fmt.Fprintf(w, "ERROR    %s %s: %s\n", describe(result.Call), result.Endpoint, result.Error)
+
+		return
+	}
+
+	if result.Match() {
+		fmt.Fprintf(w, "OK       %s %s == %s\n", describe(result.Call), result.Endpoint, result.Baseline)
+
+		return
+	}
+
+	fmt.Fprintf(w, "MISMATCH %s %s != %s\n", describe(result.Call), result.Endpoint, result.Baseline)
+
+	for _, d := range result.Differences {
+		fmt.Fprintf(w, "    %s\n", d)
+	}
+}
+
+func describe(call compare.Call) string {
+	if !compare.TakesHeight(call.RPC) {
+		return call.RPC
+	}
+
+	return fmt.Sprintf("%s(height=%d)", call.RPC, call.Height)
+}
+
+func parseCalls(opts options) ([]compare.Call, error) {
+	if len(opts.endpoints) < 2 {
+		return nil, errors.New("at least two -endpoint flags are required")
+	}
+
+	heights, err := parseHeights(opts.heights, opts.heightRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcs []string
+
+	if opts.rpcs != "" {
+		for _, rpc := range strings.Split(opts.rpcs, ",") {
+			rpcs = append(rpcs, strings.TrimSpace(rpc))
+		}
+	} else {
+		for _, rpc := range compare.RPCs() {
+			if len(heights) > 0 || !compare.TakesHeight(rpc) {
+				rpcs = append(rpcs, rpc)
+			}
+		}
+	}
+
+	return compare.Calls(rpcs, heights)
+}
+
+func parseHeights(list, heightRange string) ([]int64, error) {
+	var heights []int64
+
+	if list != "" {
+		for _, s := range strings.Split(list, ",") {
+			height, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil || height <= 0 {
+				return nil, errors.Errorf("invalid height %q", s)
+			}
+
+			heights = append(heights, height)
+		}
+	}
+
+	if heightRange != "" {
+		fromStr, toStr, found := strings.Cut(heightRange, ":")
+
+		from, errFrom := strconv.ParseInt(fromStr, 10, 64)
+		to, errTo := strconv.ParseInt(toStr, 10, 64)
+
+		if !found || errFrom != nil || errTo != nil || from <= 0 || to < from {
+			return nil, errors.Errorf("invalid height range %q, expected from:to", heightRange)
+		}
+
+		for height := from; height <= to; height++ {
+			heights = append(heights, height)
+		}
+	}
+
+	return heights, nil
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 
 	"github.com/joho/godotenv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/admin"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/applog"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/server"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/rpcproxy"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/shadow"
 )
 
 func main() {
@@ -21,13 +26,49 @@ func main() {
 
 	conf := configs.InitializeConfig()
 
-	logger := log.InitializeLogger(conf.LogLevel, conf.LogFormat)
+	logger := applog.InitializeLogger(conf)
 
-	jsonConverter := jsonconv.NewJSONConverter()
+	//nolint:errcheck
+	defer logger.Sync()
 
-	grpcServer := server.InitialiazeNewGRPCServer(ctx, conf, logger, jsonConverter)
+	// The gRPC server and the CometBFT RPC proxy share one access logger, and with it one log file.
+	accessLogger := applog.InitializeAccessLogger(conf)
 
-	forwarder.InitializeGRPCHandlers(ctx, conf.CosmosSDKGRPCEndpoint, grpcServer, logger, jsonConverter)
+	interfaceRegistry := registry.InitializeInterfaceRegistry()
+
+	jsonConverter := jsonconv.InitializeJSONConverter(conf.JSONMode, interfaceRegistry)
+
+	grpcServer := server.InitialiazeNewGRPCServer(ctx, conf, logger, accessLogger, jsonConverter)
+
+	mirror := shadow.InitializeMirror(ctx, conf, logger, jsonConverter, interfaceRegistry)
+
+	forwarder.InitializeGRPCHandlers(
+		ctx,
+		conf,
+		grpcServer,
+		logger,
+		jsonConverter,
+		interfaceRegistry,
+		mirror,
+	)
+
+	adminServer := server.InitializeNewAdminGRPCServer(ctx, conf, logger, jsonConverter)
+
+	admin.InitializeGRPCHandlers(adminServer, logger, mirror, logger.Named("admin"))
+
+	go func() {
+		if err := adminServer.Run(ctx); err != nil {
+			logger.Error("error running the admin gRPC server: ", log.Error(err))
+		}
+	}()
+
+	if rpcProxyServer := rpcproxy.InitializeNewRPCProxyServer(ctx, conf, logger, accessLogger); rpcProxyServer != nil {
+		go func() {
+			if err := rpcProxyServer.Run(ctx); err != nil {
+				logger.Error("error running the CometBFT RPC proxy server: ", log.Error(err))
+			}
+		}()
+	}
 
 	if err := grpcServer.Run(ctx); err != nil {
 		logger.Panic("error starting the gRPC server: ", log.Error(err))
@@ -0,0 +1,19 @@
+package admin
+
+import (
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/server"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/shadow"
+)
+
+// InitializeGRPCHandlers registers all admin gRPC handlers to the admin gRPC server.
+func InitializeGRPCHandlers(
+	adminServer *server.Server,
+	levels log.LevelController,
+	mirror *shadow.Mirror,
+	logger log.Logger,
+) {
+	serviceServer := NewServiceHandler(levels, mirror, logger)
+	pb.RegisterAdminServiceServer(adminServer.Instance(), serviceServer)
+}
@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/shadow"
+)
+
+// ServiceHandler implements api.cosmos.forwarder.v1.AdminService gRPC service.
+type ServiceHandler struct {
+	Levels log.LevelController
+	Mirror *shadow.Mirror
+	logger log.Logger
+
+	mu sync.Mutex
+	// resets holds the pending timed reset of each logger name.
+	resets map[string]*levelReset
+	*pb.UnimplementedAdminServiceServer
+}
+
+// levelReset is a pending revert of a temporary log level change.
+type levelReset struct {
+	timer    *time.Timer
+	previous log.Level
+	hadLevel bool
+}
+
+// NewServiceHandler is a constructor function for ServiceHandler.
+func NewServiceHandler(levels log.LevelController, mirror *shadow.Mirror, logger log.Logger) *ServiceHandler {
+	return &ServiceHandler{
+		Levels:                          levels,
+		Mirror:                          mirror,
+		logger:                          logger,
+		resets:                          make(map[string]*levelReset),
+		UnimplementedAdminServiceServer: &pb.UnimplementedAdminServiceServer{},
+	}
+}
+
+// GetLogLevel queries the effective log level of the root logger or a named logger.
+func (h *ServiceHandler) GetLogLevel(
+	ctx context.Context, req *pb.GetLogLevelRequest) (*pb.GetLogLevelResponse, error) {
+	return &pb.GetLogLevelResponse{
+		LoggerName: req.GetLoggerName(),
+		Level:      h.Levels.Level(req.GetLoggerName()).String(),
+		Explicit:   h.Levels.HasLevel(req.GetLoggerName()),
+	}, nil
+}
+
+// SetLogLevel changes the log level of the root logger or a named logger at runtime.
+func (h *ServiceHandler) SetLogLevel(
+	ctx context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
+	lvl, err := log.ParseLevel(req.GetLevel())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetResetAfterSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "reset_after_seconds cannot be negative")
+	}
+
+	name := req.GetLoggerName()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	previous := h.Levels.Level(name)
+	hadLevel := h.Levels.HasLevel(name)
+
+	// A change while a reset is pending replaces that reset, so a temporary level is always
+	// reverted to the level from before the first temporary change.
+	restoreTo, restoreHad := previous, hadLevel
+	if pending, ok := h.resets[name]; ok {
+		pending.timer.Stop()
+		delete(h.resets, name)
+
+		restoreTo, restoreHad = pending.previous, pending.hadLevel
+	}
+
+	h.Levels.SetLevel(name, lvl)
+
+	h.logger.Info("log level changed",
+		log.String("logger_name", name),
+		log.String("level", lvl.String()),
+		log.String("previous_level", previous.String()),
+		log.Int64("reset_after_seconds", req.GetResetAfterSeconds()),
+	)
+
+	if req.GetResetAfterSeconds() > 0 {
+		reset := &levelReset{previous: restoreTo, hadLevel: restoreHad}
+		reset.timer = time.AfterFunc(time.Duration(req.GetResetAfterSeconds())*time.Second, func() {
+			h.restoreLevel(name, reset)
+		})
+		h.resets[name] = reset
+	}
+
+	return &pb.SetLogLevelResponse{
+		LoggerName:    name,
+		Level:         lvl.String(),
+		PreviousLevel: previous.String(),
+	}, nil
+}
+
+// GetShadowStats queries the outcome counts of calls mirrored to the shadow upstream.
+func (h *ServiceHandler) GetShadowStats(
+	ctx context.Context, req *pb.GetShadowStatsRequest) (*pb.GetShadowStatsResponse, error) {
+	if h.Mirror == nil {
+		return nil, status.Error(codes.FailedPrecondition, "shadow traffic is disabled")
+	}
+
+	stats := h.Mirror.Stats()
+
+	return &pb.GetShadowStatsResponse{
+		ChainId:    h.Mirror.ChainID(),
+		Endpoint:   h.Mirror.Endpoint(),
+		Percent:    h.Mirror.Percent(),
+		Mirrored:   stats.Mirrored,
+		Matched:    stats.Matched,
+		Mismatched: stats.Mismatched,
+		Failed:     stats.Failed,
+		Dropped:    stats.Dropped,
+	}, nil
+}
+
+// restoreLevel reverts a temporary log level change unless it was replaced by a later
+// SetLogLevel in the meantime.
+func (h *ServiceHandler) restoreLevel(name string, reset *levelReset) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.resets[name] != reset {
+		return
+	}
+
+	delete(h.resets, name)
+
+	if reset.hadLevel {
+		h.Levels.SetLevel(name, reset.previous)
+	} else {
+		h.Levels.UnsetLevel(name)
+	}
+
+	h.logger.Info("log level restored",
+		log.String("logger_name", name),
+		log.String("level", h.Levels.Level(name).String()),
+	)
+}
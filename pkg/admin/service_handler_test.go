@@ -0,0 +1,139 @@
+package admin_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/admin"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestHandler() (*admin.ServiceHandler, *log.StructuredLogger) {
+	logger := log.New(log.WithLogToStdout(false), log.WithOutput(io.Discard), log.WithLevel(log.InfoLevel))
+
+	return admin.NewServiceHandler(logger, nil, logger.Named("admin")), logger
+}
+
+func waitForLevel(t *testing.T, levels log.LevelController, name string, want log.Level, timeout time.Duration) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+
+	for levels.Level(name) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected level %s for %q, got %s", want, name, levels.Level(name))
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServiceHandlerLogLevel(t *testing.T) {
+	ctx := context.Background()
+	handler, logger := newTestHandler()
+
+	resp, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{LoggerName: "grpc.server", Level: "debug"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.GetLevel() != "debug" || resp.GetPreviousLevel() != "info" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	got, err := handler.GetLogLevel(ctx, &pb.GetLogLevelRequest{LoggerName: "grpc.server"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.GetLevel() != "debug" || !got.GetExplicit() {
+		t.Errorf("unexpected level: %+v", got)
+	}
+
+	if logger.Level("") != log.InfoLevel {
+		t.Errorf("expected root level to stay info, got %s", logger.Level(""))
+	}
+
+	for _, req := range []*pb.SetLogLevelRequest{
+		{Level: "verbose"},
+		{Level: "debug", ResetAfterSeconds: -1},
+	} {
+		if _, err := handler.SetLogLevel(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument for %+v, got %v", req, err)
+		}
+	}
+}
+
+func TestServiceHandlerLogLevelReset(t *testing.T) {
+	ctx := context.Background()
+	handler, logger := newTestHandler()
+
+	if _, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{
+		LoggerName: "upstream", Level: "debug", ResetAfterSeconds: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForLevel(t, logger, "upstream", log.InfoLevel, 3*time.Second)
+
+	if logger.HasLevel("upstream") {
+		t.Error("expected the level override to be removed")
+	}
+}
+
+func TestServiceHandlerLogLevelResetReplaced(t *testing.T) {
+	ctx := context.Background()
+	handler, logger := newTestHandler()
+
+	if _, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{
+		LoggerName: "upstream", Level: "debug", ResetAfterSeconds: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The same level with a longer TTL must outlive the first reset.
+	if _, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{
+		LoggerName: "upstream", Level: "debug", ResetAfterSeconds: 2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(1500 * time.Millisecond)
+
+	if got := logger.Level("upstream"); got != log.DebugLevel {
+		t.Fatalf("expected the first reset to be cancelled, got %s", got)
+	}
+
+	waitForLevel(t, logger, "upstream", log.InfoLevel, 3*time.Second)
+
+	if logger.HasLevel("upstream") {
+		t.Error("expected the level from before the first change to be restored")
+	}
+}
+
+func TestServiceHandlerLogLevelResetCancelled(t *testing.T) {
+	ctx := context.Background()
+	handler, logger := newTestHandler()
+
+	if _, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{
+		LoggerName: "upstream", Level: "debug", ResetAfterSeconds: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A change without a TTL is permanent and cancels the pending reset.
+	if _, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{LoggerName: "upstream", Level: "warn"}); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(1500 * time.Millisecond)
+
+	if got := logger.Level("upstream"); got != log.WarnLevel {
+		t.Errorf("expected the level to stay warn, got %s", got)
+	}
+}
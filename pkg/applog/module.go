@@ -0,0 +1,102 @@
+// Package applog builds the application loggers of pkg/log from the app config.
+package applog
+
+import (
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// InitializeLogger configures the application logger with all log sinks enabled in the config.
+func InitializeLogger(conf *configs.Config) *log.StructuredLogger {
+	lf, err := log.ParseFormat(conf.LogFormat)
+	if err != nil {
+		panic(err)
+	}
+
+	ll, err := log.ParseLevel(conf.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	opts := []log.Option{
+		log.WithFormat(lf),
+		log.WithLevel(ll),
+		log.AddCaller(),
+		log.WithLogToStdout(conf.LogToStdout),
+	}
+
+	if conf.LogFilePath != "" {
+		opts = append(opts, log.WithSink(log.Sink{
+			Writer: log.NewFileWriter(fileConfig(conf, conf.LogFilePath)),
+			Format: mustParseFormat(conf.LogFileFormat),
+			Level:  mustParseLevel(conf.LogFileLevel),
+		}))
+	}
+
+	if conf.LogSyslogEnabled {
+		w, err := log.NewSyslogWriter(conf.LogSyslogTag)
+		if err != nil {
+			panic(err)
+		}
+
+		opts = append(opts, log.WithSink(log.Sink{
+			Writer: w,
+			Format: mustParseFormat(conf.LogSyslogFormat),
+			Level:  mustParseLevel(conf.LogSyslogLevel),
+		}))
+	}
+
+	return log.New(opts...)
+}
+
+// InitializeAccessLogger configures a dedicated logger for access log entries.
+// Access logs are written to their own file, or to stdout if no file is configured.
+// It returns nil if access logging is disabled.
+func InitializeAccessLogger(conf *configs.Config) *log.StructuredLogger {
+	if !conf.AccessLogEnabled {
+		return nil
+	}
+
+	opts := []log.Option{
+		log.WithFormat(mustParseFormat(conf.AccessLogFormat)),
+		log.WithLevel(mustParseLevel(conf.AccessLogLevel)),
+		log.WithCaller(false),
+	}
+
+	if conf.AccessLogFilePath != "" {
+		opts = append(opts,
+			log.WithLogToStdout(false),
+			log.WithOutput(log.NewFileWriter(fileConfig(conf, conf.AccessLogFilePath))),
+		)
+	}
+
+	return log.New(opts...)
+}
+
+func fileConfig(conf *configs.Config, path string) log.FileConfig {
+	return log.FileConfig{
+		Path:       path,
+		MaxSizeMB:  conf.LogFileMaxSizeMB,
+		MaxAgeDays: conf.LogFileMaxAgeDays,
+		MaxBackups: conf.LogFileMaxBackups,
+		Compress:   conf.LogFileCompress,
+	}
+}
+
+func mustParseFormat(format string) log.Format {
+	lf, err := log.ParseFormat(format)
+	if err != nil {
+		panic(err)
+	}
+
+	return lf
+}
+
+func mustParseLevel(lvl string) log.Level {
+	ll, err := log.ParseLevel(lvl)
+	if err != nil {
+		panic(err)
+	}
+
+	return ll
+}
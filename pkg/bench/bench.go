@@ -0,0 +1,175 @@
+// Package bench drives a configurable mix of RPCs against a forwarder and reports latencies,
+// throughput and errors.
+package bench
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+// Config configures a benchmark run.
+type Config struct {
+	Mix      *Mix
+	Duration time.Duration
+	// Concurrency is the number of workers, i.e. the maximum number of requests in flight.
+	Concurrency int
+	// RPS is the target request rate across all workers. Zero means every worker sends its next
+	// request as soon as the previous one has completed.
+	RPS float64
+	// MinHeight and MaxHeight bound the heights requested from RPCs which take a height.
+	MinHeight int64
+	MaxHeight int64
+	// Timeout bounds every request.
+	Timeout time.Duration
+	// Seed makes the sequence of RPCs and heights reproducible.
+	Seed int64
+}
+
+// Validate checks that the config describes a runnable benchmark.
+func (c Config) Validate() error {
+	switch {
+	case c.Mix == nil:
+		return errors.New("missing RPC mix")
+	case c.Duration <= 0:
+		return errors.New("duration must be positive")
+	case c.Concurrency <= 0:
+		return errors.New("concurrency must be positive")
+	case c.RPS < 0:
+		return errors.New("RPS must not be negative")
+	case c.Timeout <= 0:
+		return errors.New("timeout must be positive")
+	case c.MinHeight <= 0 || c.MaxHeight < c.MinHeight:
+		return errors.Errorf("invalid height range %d:%d", c.MinHeight, c.MaxHeight)
+	}
+
+	return nil
+}
+
+// Run runs the benchmark against serviceClient until config.Duration has passed or ctx is done.
+//
+// With a target RPS requests are scheduled at a fixed rate and their latency is measured from the
+// scheduled time, so time spent waiting for a free worker counts against the forwarder instead of
+// hiding the overload.
+func Run(ctx context.Context, serviceClient pb.ServiceClient, config Config) (*Report, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Requests are sent with ctx so that those in flight when runCtx ends are allowed to complete.
+	runCtx, cancel := context.WithTimeout(ctx, config.Duration)
+	defer cancel()
+
+	var scheduled chan time.Time
+
+	if config.RPS > 0 {
+		scheduled = make(chan time.Time, config.Concurrency)
+
+		go schedule(runCtx, config.RPS, scheduled)
+	}
+
+	samples := make([][]sample, config.Concurrency)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < config.Concurrency; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			w := &worker{
+				serviceClient: serviceClient,
+				config:        config,
+				rand:          rand.New(rand.NewSource(config.Seed + int64(i))), //nolint:gosec
+			}
+
+			samples[i] = w.run(runCtx, ctx, scheduled)
+		}(i)
+	}
+
+	wg.Wait()
+
+	var all []sample
+	for _, s := range samples {
+		all = append(all, s...)
+	}
+
+	return newReport(time.Since(start), all), nil
+}
+
+// schedule sends the scheduled start time of every request until ctx is done.
+func schedule(ctx context.Context, rps float64, scheduled chan<- time.Time) {
+	defer close(scheduled)
+
+	interval := time.Duration(float64(time.Second) / rps)
+	next := time.Now()
+
+	for {
+		if wait := time.Until(next); wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return
+			case <-timer.C:
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case scheduled <- next:
+		}
+
+		next = next.Add(interval)
+	}
+}
+
+type worker struct {
+	serviceClient pb.ServiceClient
+	config        Config
+	rand          *rand.Rand
+}
+
+// run sends requests with ctx until runCtx is done, either as scheduled or back to back when
+// scheduled is nil.
+func (w *worker) run(runCtx, ctx context.Context, scheduled <-chan time.Time) []sample {
+	var samples []sample
+
+	if scheduled != nil {
+		for start := range scheduled {
+			samples = append(samples, w.call(ctx, start))
+		}
+
+		return samples
+	}
+
+	for runCtx.Err() == nil && ctx.Err() == nil {
+		samples = append(samples, w.call(ctx, time.Now()))
+	}
+
+	return samples
+}
+
+// call sends one request of the mix.
+func (w *worker) call(ctx context.Context, start time.Time) sample {
+	rpc := w.config.Mix.pick(w.rand)
+	height := w.config.MinHeight + w.rand.Int63n(w.config.MaxHeight-w.config.MinHeight+1)
+
+	callCtx, cancel := context.WithTimeout(ctx, w.config.Timeout)
+	defer cancel()
+
+	err := _calls[rpc](callCtx, w.serviceClient, height)
+
+	return sample{rpc: rpc, latency: time.Since(start), code: status.Code(err)}
+}
@@ -0,0 +1,143 @@
+package bench_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/bench"
+)
+
+func newInProcessConfig(t *testing.T, mix string) bench.Config {
+	t.Helper()
+
+	m, err := bench.ParseMix(mix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bench.Config{
+		Mix:         m,
+		Duration:    300 * time.Millisecond,
+		Concurrency: 4,
+		MinHeight:   1,
+		MaxHeight:   100,
+		Timeout:     time.Second,
+	}
+}
+
+func TestRunInProcess(t *testing.T) {
+	ctx := context.Background()
+
+	serviceClient, fake, closer, err := bench.NewInProcessClient(ctx, bench.InProcessConfig{
+		ChainID:      "bench-1",
+		LatestHeight: 100,
+		Validators:   4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(closer)
+
+	fake.FailWith("GetSyncing", status.Error(codes.Unavailable, "down"))
+
+	report, err := bench.Run(ctx, serviceClient, newInProcessConfig(t, "GetBlockByHeight=3,GetSyncing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Requests == 0 || report.Throughput <= 0 {
+		t.Fatalf("expected requests, got %+v", report)
+	}
+
+	blocks, syncing := report.RPCs["GetBlockByHeight"], report.RPCs["GetSyncing"]
+
+	if blocks.Requests+syncing.Requests != report.Requests || blocks.Errors != 0 {
+		t.Errorf("unexpected per RPC counts %+v %+v", blocks, syncing)
+	}
+
+	if syncing.Requests == 0 || syncing.Errors != syncing.Requests || report.Codes["Unavailable"] != syncing.Errors {
+		t.Errorf("expected all GetSyncing requests to fail with Unavailable, got %+v", syncing)
+	}
+
+	l := report.Latency
+	if l.Min <= 0 || l.Min > l.P50 || l.P50 > l.P90 || l.P90 > l.P99 || l.P99 > l.Max {
+		t.Errorf("inconsistent latencies %+v", l)
+	}
+
+	var text bytes.Buffer
+	if err := report.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(text.String(), "Unavailable=") || !strings.Contains(text.String(), "GetBlockByHeight") {
+		t.Errorf("unexpected text report:\n%s", text.String())
+	}
+
+	if _, err := json.Marshal(report); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunTargetRPS(t *testing.T) {
+	ctx := context.Background()
+
+	serviceClient, _, closer, err := bench.NewInProcessClient(ctx, bench.InProcessConfig{ChainID: "bench-1", LatestHeight: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(closer)
+
+	config := newInProcessConfig(t, "GetLatestBlock")
+	config.RPS = 100
+	config.Duration = 500 * time.Millisecond
+
+	report, err := bench.Run(ctx, serviceClient, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 50 requests are scheduled; allow for a slow scheduler.
+	if report.Requests < 25 || report.Requests > 51 {
+		t.Errorf("expected about 50 requests at 100 RPS, got %d", report.Requests)
+	}
+}
+
+func TestParseMix(t *testing.T) {
+	mix, err := bench.ParseMix("GetLatestBlock=3, GetSyncing, GetNodeInfo=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := strings.Join(mix.RPCs(), ","); got != "GetLatestBlock,GetSyncing" {
+		t.Errorf("unexpected RPCs %s", got)
+	}
+
+	for _, spec := range []string{"BroadcastTx", "GetSyncing=-1", "GetSyncing=x", "GetSyncing=0"} {
+		if _, err := bench.ParseMix(spec); err == nil {
+			t.Errorf("%s: expected an error", spec)
+		}
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := newInProcessConfig(t, bench.DefaultMix)
+
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	config.MaxHeight = 0
+
+	if err := config.Validate(); err == nil {
+		t.Error("expected an error for an empty height range")
+	}
+}
@@ -0,0 +1,41 @@
+package bench
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// InProcessConfig configures the fake chain served by an in-process forwarder.
+type InProcessConfig struct {
+	ChainID      string
+	LatestHeight int64
+	Validators   int
+	// Latency is added by the fake upstream to every call.
+	Latency time.Duration
+}
+
+// NewInProcessClient serves a forwarder in front of a fake upstream over bufconn, so that a benchmark
+// runs without any network. The returned function stops both servers.
+func NewInProcessClient(ctx context.Context, config InProcessConfig) (pb.ServiceClient, *testrunner.FakeUpstream, func(), error) {
+	fake := testrunner.NewFakeUpstream(config.ChainID, config.LatestHeight, config.Validators)
+	fake.SetLatency(config.Latency)
+
+	// Logging is kept on but discarded, so that its cost is part of the measurement.
+	logger := log.New(log.WithLogToStdout(false))
+
+	testConfig := testrunner.NewDefaultTestConfig(logger, &configs.Config{}, jsonconv.NewJSONConverter())
+	testConfig.Upstreams = []*testrunner.FakeUpstream{fake}
+
+	conn, closer, err := testrunner.NewUnaryTestSetup(ctx, testConfig)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return pb.NewServiceClient(conn), fake, closer, nil
+}
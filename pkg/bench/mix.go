@@ -0,0 +1,143 @@
+package bench
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+// _defaultPageSize is the validator-set page size of the calls of paginated RPCs.
+const _defaultPageSize = 100
+
+// callFunc calls an RPC. height is a height within the configured range for RPCs which take one.
+type callFunc func(ctx context.Context, serviceClient pb.ServiceClient, height int64) error
+
+var _calls = map[string]callFunc{
+	"GetNodeInfo": func(ctx context.Context, serviceClient pb.ServiceClient, _ int64) error {
+		_, err := serviceClient.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{})
+
+		return err
+	},
+	"GetSyncing": func(ctx context.Context, serviceClient pb.ServiceClient, _ int64) error {
+		_, err := serviceClient.GetSyncing(ctx, &pb.GetSyncingRequest{})
+
+		return err
+	},
+	"GetLatestBlock": func(ctx context.Context, serviceClient pb.ServiceClient, _ int64) error {
+		_, err := serviceClient.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{})
+
+		return err
+	},
+	"GetBlockByHeight": func(ctx context.Context, serviceClient pb.ServiceClient, height int64) error {
+		_, err := serviceClient.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: height})
+
+		return err
+	},
+	"GetLatestValidatorSet": func(ctx context.Context, serviceClient pb.ServiceClient, _ int64) error {
+		_, err := serviceClient.GetLatestValidatorSet(ctx, &pb.GetLatestValidatorSetRequest{
+			Pagination: &query.PageRequest{Limit: _defaultPageSize},
+		})
+
+		return err
+	},
+	"GetValidatorSetByHeight": func(ctx context.Context, serviceClient pb.ServiceClient, height int64) error {
+		_, err := serviceClient.GetValidatorSetByHeight(ctx, &pb.GetValidatorSetByHeightRequest{
+			Height:     height,
+			Pagination: &query.PageRequest{Limit: _defaultPageSize},
+		})
+
+		return err
+	},
+	"GetFullValidatorSet": func(ctx context.Context, serviceClient pb.ServiceClient, height int64) error {
+		_, err := serviceClient.GetFullValidatorSet(ctx, &pb.GetFullValidatorSetRequest{Height: height})
+
+		return err
+	},
+}
+
+// RPCs returns the names of all RPCs a Mix can contain in alphabetical order.
+func RPCs() []string {
+	rpcs := make([]string, 0, len(_calls))
+	for rpc := range _calls {
+		rpcs = append(rpcs, rpc)
+	}
+
+	sort.Strings(rpcs)
+
+	return rpcs
+}
+
+// Mix is a weighted set of RPCs. Each request calls one of them, chosen in proportion to the weights.
+type Mix struct {
+	rpcs    []string
+	weights []int
+	total   int
+}
+
+// DefaultMix is a read-heavy mix dominated by block queries.
+const DefaultMix = "GetLatestBlock=4,GetBlockByHeight=4,GetSyncing=1,GetNodeInfo=1,GetValidatorSetByHeight=2"
+
+// ParseMix parses a mix in the format "rpc=weight,rpc=weight". A missing weight means 1.
+func ParseMix(spec string) (*Mix, error) {
+	m := &Mix{}
+
+	for _, part := range strings.Split(spec, ",") {
+		rpc, weightStr, found := strings.Cut(strings.TrimSpace(part), "=")
+
+		weight := 1
+
+		if found {
+			var err error
+
+			weight, err = strconv.Atoi(weightStr)
+			if err != nil || weight < 0 {
+				return nil, errors.Errorf("invalid weight in %q", part)
+			}
+		}
+
+		if _, ok := _calls[rpc]; !ok {
+			return nil, errors.Errorf("unknown RPC %q, expected one of %s", rpc, strings.Join(RPCs(), ", "))
+		}
+
+		if weight == 0 {
+			continue
+		}
+
+		m.rpcs = append(m.rpcs, rpc)
+		m.weights = append(m.weights, weight)
+		m.total += weight
+	}
+
+	if m.total == 0 {
+		return nil, errors.New("the mix contains no RPC with a positive weight")
+	}
+
+	return m, nil
+}
+
+// RPCs returns the RPCs of the mix in the parsed order.
+func (m *Mix) RPCs() []string {
+	return m.rpcs
+}
+
+// pick chooses an RPC of the mix.
+func (m *Mix) pick(r *rand.Rand) string {
+	n := r.Intn(m.total)
+
+	for i, weight := range m.weights {
+		if n < weight {
+			return m.rpcs[i]
+		}
+
+		n -= weight
+	}
+
+	return m.rpcs[len(m.rpcs)-1]
+}
@@ -0,0 +1,198 @@
+package bench
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Latency summarizes a latency distribution.
+type Latency struct {
+	Min  time.Duration `json:"min"`
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P90  time.Duration `json:"p90"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+}
+
+// RPCReport is the outcome of the requests of one RPC.
+type RPCReport struct {
+	Requests int            `json:"requests"`
+	Errors   int            `json:"errors"`
+	Latency  Latency        `json:"latency"`
+	Codes    map[string]int `json:"codes,omitempty"`
+}
+
+// Report is the outcome of a benchmark run. Durations are encoded in JSON as nanoseconds.
+type Report struct {
+	Duration   time.Duration        `json:"duration"`
+	Requests   int                  `json:"requests"`
+	Errors     int                  `json:"errors"`
+	Throughput float64              `json:"throughput"`
+	Latency    Latency              `json:"latency"`
+	Codes      map[string]int       `json:"codes,omitempty"`
+	RPCs       map[string]RPCReport `json:"rpcs"`
+}
+
+// sample is the outcome of a single request.
+type sample struct {
+	rpc     string
+	latency time.Duration
+	code    codes.Code
+}
+
+func newReport(duration time.Duration, samples []sample) *Report {
+	r := &Report{
+		Duration: duration,
+		Requests: len(samples),
+		RPCs:     make(map[string]RPCReport),
+	}
+
+	if duration > 0 {
+		r.Throughput = float64(len(samples)) / duration.Seconds()
+	}
+
+	var all []time.Duration
+
+	byRPC := make(map[string][]time.Duration)
+
+	for _, s := range samples {
+		all = append(all, s.latency)
+		byRPC[s.rpc] = append(byRPC[s.rpc], s.latency)
+
+		rpc := r.RPCs[s.rpc]
+		rpc.Requests++
+
+		if s.code != codes.OK {
+			r.Errors++
+			rpc.Errors++
+			r.Codes = increment(r.Codes, s.code)
+			rpc.Codes = increment(rpc.Codes, s.code)
+		}
+
+		r.RPCs[s.rpc] = rpc
+	}
+
+	r.Latency = summarize(all)
+
+	for name, latencies := range byRPC {
+		rpc := r.RPCs[name]
+		rpc.Latency = summarize(latencies)
+		r.RPCs[name] = rpc
+	}
+
+	return r
+}
+
+func increment(counts map[string]int, code codes.Code) map[string]int {
+	if counts == nil {
+		counts = make(map[string]int)
+	}
+
+	counts[code.String()]++
+
+	return counts
+}
+
+func summarize(latencies []time.Duration) Latency {
+	if len(latencies) == 0 {
+		return Latency{}
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	var sum time.Duration
+	for _, l := range latencies {
+		sum += l
+	}
+
+	return Latency{
+		Min:  latencies[0],
+		Mean: sum / time.Duration(len(latencies)),
+		P50:  percentile(latencies, 50),
+		P90:  percentile(latencies, 90),
+		P99:  percentile(latencies, 99),
+		Max:  latencies[len(latencies)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// WriteText writes the report as a human readable summary followed by a table per RPC.
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Duration:\t%s\n", r.Duration.Round(time.Millisecond))
+	fmt.Fprintf(tw, "Requests:\t%d\n", r.Requests)
+	fmt.Fprintf(tw, "Errors:\t%d\n", r.Errors)
+	fmt.Fprintf(tw, "Throughput:\t%.1f req/s\n", r.Throughput)
+	fmt.Fprintf(tw, "Latency:\tmin %s, mean %s, p50 %s, p90 %s, p99 %s, max %s\n",
+		round(r.Latency.Min), round(r.Latency.Mean), round(r.Latency.P50),
+		round(r.Latency.P90), round(r.Latency.P99), round(r.Latency.Max))
+
+	if len(r.Codes) > 0 {
+		fmt.Fprintf(tw, "Error codes:\t%s\n", formatCodes(r.Codes))
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "RPC\tREQUESTS\tERRORS\tP50\tP90\tP99\tMAX\tERROR CODES")
+
+	names := make([]string, 0, len(r.RPCs))
+	for name := range r.RPCs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		rpc := r.RPCs[name]
+
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n", name, rpc.Requests, rpc.Errors,
+			round(rpc.Latency.P50), round(rpc.Latency.P90), round(rpc.Latency.P99), round(rpc.Latency.Max),
+			formatCodes(rpc.Codes))
+	}
+
+	return tw.Flush()
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
+
+func formatCodes(counts map[string]int) string {
+	if len(counts) == 0 {
+		return "-"
+	}
+
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	s := ""
+
+	for i, name := range names {
+		if i > 0 {
+			s += ", "
+		}
+
+		s += fmt.Sprintf("%s=%d", name, counts[name])
+	}
+
+	return s
+}
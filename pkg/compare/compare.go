@@ -0,0 +1,189 @@
+// Package compare runs the same RPCs against several gRPC endpoints and reports how their responses differ.
+package compare
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsondiff"
+)
+
+// _defaultPageSize is large enough to fetch the validator sets of all Cosmos SDK chains in one page.
+const _defaultPageSize = 500
+
+// Call is a single RPC call. Height is zero for RPCs which do not take one.
+type Call struct {
+	RPC    string `json:"rpc"`
+	Height int64  `json:"height,omitempty"`
+}
+
+// Calls expands rpcs and heights into calls: RPCs which take a height are called once per height,
+// the others once.
+func Calls(rpcs []string, heights []int64) ([]Call, error) {
+	var calls []Call
+
+	for _, rpc := range rpcs {
+		if _, ok := _rpcs[rpc]; !ok {
+			return nil, errors.Errorf("unknown RPC %q, expected one of %s", rpc, strings.Join(RPCs(), ", "))
+		}
+
+		if !TakesHeight(rpc) {
+			calls = append(calls, Call{RPC: rpc})
+
+			continue
+		}
+
+		if len(heights) == 0 {
+			return nil, errors.Errorf("RPC %s requires at least one height", rpc)
+		}
+
+		for _, height := range heights {
+			calls = append(calls, Call{RPC: rpc, Height: height})
+		}
+	}
+
+	return calls, nil
+}
+
+// Result is the comparison of the response of one endpoint with the response of the baseline endpoint.
+// Error is set when the responses couldn't be compared, e.g. because either endpoint was unreachable.
+type Result struct {
+	Call
+	Baseline    string                `json:"baseline"`
+	Endpoint    string                `json:"endpoint"`
+	Differences []jsondiff.Difference `json:"differences"`
+	Error       string                `json:"error,omitempty"`
+}
+
+// Match reports whether the endpoint answered like the baseline.
+func (r Result) Match() bool {
+	return r.Error == "" && len(r.Differences) == 0
+}
+
+// Comparer compares the responses of several endpoints against the first one.
+type Comparer struct {
+	endpoints     []*Endpoint
+	jsonConverter *jsonconv.JSONConverter
+	ignore        []string
+	pageSize      uint64
+}
+
+// NewComparer is a constructor function for Comparer. The first endpoint is the baseline. Differences
+// at or below the ignored paths, e.g. defaultNodeInfo.moniker, are not reported.
+func NewComparer(jsonConverter *jsonconv.JSONConverter, ignore []string, endpoints ...*Endpoint) (*Comparer, error) {
+	if len(endpoints) < 2 {
+		return nil, errors.New("at least two endpoints are required")
+	}
+
+	return &Comparer{
+		endpoints:     endpoints,
+		jsonConverter: jsonConverter,
+		ignore:        ignore,
+		pageSize:      _defaultPageSize,
+	}, nil
+}
+
+// Compare runs call against all endpoints concurrently and compares each response with the
+// baseline. Errors are compared by their gRPC status code and message, except for Unavailable
+// errors, which mean an endpoint could not be reached. They are reported in the Error of the
+// affected results instead, like responses which cannot be rendered.
+func (c *Comparer) Compare(ctx context.Context, call Call) []Result {
+	rendered := make([][]byte, len(c.endpoints))
+	errs := make([]error, len(c.endpoints))
+
+	var wg sync.WaitGroup
+
+	for i, endpoint := range c.endpoints {
+		wg.Add(1)
+
+		go func(i int, endpoint *Endpoint) {
+			defer wg.Done()
+
+			rendered[i], errs[i] = c.render(ctx, endpoint, call)
+		}(i, endpoint)
+	}
+
+	wg.Wait()
+
+	baseline := c.endpoints[0]
+	results := make([]Result, 0, len(c.endpoints)-1)
+
+	for i, endpoint := range c.endpoints[1:] {
+		result := Result{
+			Call:     call,
+			Baseline: baseline.Name,
+			Endpoint: endpoint.Name,
+		}
+
+		err := errs[0]
+		if err == nil {
+			err = errs[i+1]
+		}
+
+		if err == nil {
+			var diffs []jsondiff.Difference
+
+			if diffs, err = jsondiff.Compare(rendered[0], rendered[i+1]); err == nil {
+				result.Differences = c.filter(diffs)
+			}
+		}
+
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// render calls the endpoint and renders its response, or its error status, as JSON.
+func (c *Comparer) render(ctx context.Context, endpoint *Endpoint, call Call) ([]byte, error) {
+	resp, err := endpoint.Call(ctx, call.RPC, call.Height, c.pageSize)
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.Unavailable {
+			return nil, errors.Wrapf(err, "cannot call %s on %s", call.RPC, endpoint.Name)
+		}
+
+		return c.jsonConverter.Marshal(map[string]any{
+			"error": map[string]any{"code": st.Code().String(), "message": st.Message()},
+		})
+	}
+
+	b, err := c.jsonConverter.Marshal(resp)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot render %s response of %s", call.RPC, endpoint.Name)
+	}
+
+	return b, nil
+}
+
+func (c *Comparer) filter(diffs []jsondiff.Difference) []jsondiff.Difference {
+	kept := diffs[:0]
+
+	for _, d := range diffs {
+		if !c.ignored(d.Path) {
+			kept = append(kept, d)
+		}
+	}
+
+	return kept
+}
+
+func (c *Comparer) ignored(path string) bool {
+	for _, p := range c.ignore {
+		if path == p || strings.HasPrefix(path, p+".") || strings.HasPrefix(path, p+"[") {
+			return true
+		}
+	}
+
+	return false
+}
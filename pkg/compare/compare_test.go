@@ -0,0 +1,159 @@
+package compare_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/compare"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+// newForwarderEndpoint serves a forwarder in front of fake.
+func newForwarderEndpoint(t *testing.T, fake *testrunner.FakeUpstream) *compare.Endpoint {
+	t.Helper()
+
+	logger := log.InitializeLogger("error", "json")
+	config := testrunner.NewDefaultTestConfig(logger, &configs.Config{}, jsonconv.NewJSONConverter())
+	config.Upstreams = []*testrunner.FakeUpstream{fake}
+
+	conn, closer, err := testrunner.NewUnaryTestSetup(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(closer)
+
+	return compare.NewEndpoint("forwarder", compare.KindForwarder, conn)
+}
+
+// newCosmosEndpoint connects to fake directly.
+func newCosmosEndpoint(t *testing.T, name string, fake *testrunner.FakeUpstream) *compare.Endpoint {
+	t.Helper()
+
+	conn, closer, err := fake.Dial(context.Background(), registry.NewInterfaceRegistry(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(closer)
+
+	return compare.NewEndpoint(name, compare.KindCosmos, conn)
+}
+
+func TestComparerMatchesForwarderAndNode(t *testing.T) {
+	fake := testrunner.NewFakeUpstream("test-1", 100, 4)
+	fake.SetTxs(90, []byte("tx"))
+
+	comparer, err := compare.NewComparer(jsonconv.NewJSONConverter(jsonconv.WithMode(jsonconv.ModeProto3)), nil,
+		newCosmosEndpoint(t, "node", fake),
+		newForwarderEndpoint(t, testrunner.NewFakeUpstream("test-1", 100, 4)),
+		newForwarderEndpoint(t, fake),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls, err := compare.Calls(compare.RPCs(), []int64{90, 100, 101})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, call := range calls {
+		results := comparer.Compare(context.Background(), call)
+
+		for i, result := range results {
+			// The second forwarder's chain has no transaction at height 90.
+			wantMatch := i == 1 || call.Height != 90 || call.RPC != "GetBlockByHeight"
+			if result.Match() != wantMatch {
+				t.Errorf("%s at height %d against endpoint %d: expected match %v, got differences %v %s",
+					call.RPC, call.Height, i, wantMatch, result.Differences, result.Error)
+			}
+		}
+	}
+}
+
+func TestComparerReportsDifferences(t *testing.T) {
+	baseline := testrunner.NewFakeUpstream("test-1", 100, 4)
+	other := testrunner.NewFakeUpstream("test-1", 100, 4)
+	other.SetSyncing(true)
+	other.SetCosmosSDKVersion("v0.50.1")
+
+	comparer, err := compare.NewComparer(jsonconv.NewJSONConverter(), []string{"applicationVersion.cosmosSdkVersion"},
+		newCosmosEndpoint(t, "baseline", baseline),
+		newCosmosEndpoint(t, "other", other),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	results := comparer.Compare(ctx, compare.Call{RPC: "GetSyncing"})
+
+	if len(results) != 1 || len(results[0].Differences) != 1 || results[0].Differences[0].Path != "syncing" {
+		t.Fatalf("expected a single syncing difference, got %+v", results)
+	}
+
+	if results[0].Baseline != "baseline" || results[0].Endpoint != "other" {
+		t.Errorf("unexpected endpoints %q and %q", results[0].Baseline, results[0].Endpoint)
+	}
+
+	results = comparer.Compare(ctx, compare.Call{RPC: "GetNodeInfo"})
+
+	if !results[0].Match() {
+		t.Errorf("expected the ignored Cosmos SDK version to match, got %v", results[0].Differences)
+	}
+
+	other.FailWith("GetLatestBlock", status.Error(codes.Unavailable, "down"))
+
+	results = comparer.Compare(ctx, compare.Call{RPC: "GetLatestBlock"})
+	if results[0].Match() || !strings.Contains(results[0].Error, "cannot call GetLatestBlock on other") {
+		t.Errorf("expected an unreachable endpoint to fail the comparison, got %+v", results[0])
+	}
+}
+
+func TestCalls(t *testing.T) {
+	calls, err := compare.Calls([]string{"GetSyncing", "GetBlockByHeight"}, []int64{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(calls) != 3 || calls[2] != (compare.Call{RPC: "GetBlockByHeight", Height: 2}) {
+		t.Errorf("unexpected calls %v", calls)
+	}
+
+	if _, err := compare.Calls([]string{"GetBlockByHeight"}, nil); err == nil {
+		t.Error("expected an error for a height RPC without heights")
+	}
+
+	if _, err := compare.Calls([]string{"BroadcastTx"}, nil); err == nil {
+		t.Error("expected an error for an unknown RPC")
+	}
+}
+
+func TestParseEndpoint(t *testing.T) {
+	for spec, want := range map[string]compare.Kind{
+		"localhost:9090":           compare.KindCosmos,
+		"cosmos=localhost:9090":    compare.KindCosmos,
+		"forwarder=localhost:8080": compare.KindForwarder,
+	} {
+		kind, addr, err := compare.ParseEndpoint(spec)
+		if err != nil || kind != want || addr[:9] != "localhost" {
+			t.Errorf("%s: unexpected %s %s %v", spec, kind, addr, err)
+		}
+	}
+
+	for _, spec := range []string{"grpc=localhost:9090", "forwarder="} {
+		if _, _, err := compare.ParseEndpoint(spec); err == nil {
+			t.Errorf("%s: expected an error", spec)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package compare
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+// Kind is the service an endpoint serves.
+type Kind string
+
+const (
+	// KindForwarder is an endpoint serving api.cosmos.forwarder.v1.Service.
+	KindForwarder Kind = "forwarder"
+	// KindCosmos is a Cosmos SDK node serving cosmos.base.tendermint.v1beta1.Service.
+	KindCosmos Kind = "cosmos"
+)
+
+// _rpcs maps the comparable RPCs to whether they take a height. ABCIQuery is left out, since its
+// query path and data are specific to the modules of each chain and have no meaningful default.
+var _rpcs = map[string]bool{
+	"GetNodeInfo":             false,
+	"GetSyncing":              false,
+	"GetLatestBlock":          false,
+	"GetLatestValidatorSet":   false,
+	"GetBlockByHeight":        true,
+	"GetValidatorSetByHeight": true,
+}
+
+// RPCs returns the names of all comparable RPCs in alphabetical order.
+func RPCs() []string {
+	rpcs := make([]string, 0, len(_rpcs))
+	for rpc := range _rpcs {
+		rpcs = append(rpcs, rpc)
+	}
+
+	sort.Strings(rpcs)
+
+	return rpcs
+}
+
+// TakesHeight reports whether rpc is called once per height.
+func TakesHeight(rpc string) bool {
+	return _rpcs[rpc]
+}
+
+// Endpoint is a gRPC endpoint whose responses are compared.
+type Endpoint struct {
+	Name string
+	Kind Kind
+
+	forwarderClient pb.ServiceClient
+	cosmosClient    tmservice.ServiceClient
+}
+
+// NewEndpoint is a constructor function for Endpoint.
+func NewEndpoint(name string, kind Kind, conn grpc.ClientConnInterface) *Endpoint {
+	return &Endpoint{
+		Name:            name,
+		Kind:            kind,
+		forwarderClient: pb.NewServiceClient(conn),
+		cosmosClient:    tmservice.NewServiceClient(conn),
+	}
+}
+
+// ParseEndpoint splits an endpoint flag of the form [forwarder=|cosmos=]host:port.
+// An address without kind is a Cosmos SDK node.
+func ParseEndpoint(spec string) (Kind, string, error) {
+	kind, addr, found := strings.Cut(spec, "=")
+	if !found {
+		return KindCosmos, spec, nil
+	}
+
+	switch Kind(kind) {
+	case KindForwarder, KindCosmos:
+	default:
+		return "", "", errors.Errorf("invalid endpoint %q: kind must be %s or %s", spec, KindForwarder, KindCosmos)
+	}
+
+	if addr == "" {
+		return "", "", errors.Errorf("invalid endpoint %q: missing address", spec)
+	}
+
+	return Kind(kind), addr, nil
+}
+
+// Call calls rpc on the endpoint. height is ignored by RPCs which do not take one. Validator sets
+// are requested in a single page of pageSize validators.
+func (e *Endpoint) Call(ctx context.Context, rpc string, height int64, pageSize uint64) (proto.Message, error) {
+	pagination := &query.PageRequest{Limit: pageSize, CountTotal: true}
+
+	if e.Kind == KindForwarder {
+		switch rpc {
+		case "GetNodeInfo":
+			return e.forwarderClient.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{})
+		case "GetSyncing":
+			return e.forwarderClient.GetSyncing(ctx, &pb.GetSyncingRequest{})
+		case "GetLatestBlock":
+			return e.forwarderClient.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{})
+		case "GetLatestValidatorSet":
+			return e.forwarderClient.GetLatestValidatorSet(ctx, &pb.GetLatestValidatorSetRequest{Pagination: pagination})
+		case "GetBlockByHeight":
+			return e.forwarderClient.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: height})
+		case "GetValidatorSetByHeight":
+			return e.forwarderClient.GetValidatorSetByHeight(ctx, &pb.GetValidatorSetByHeightRequest{
+				Height:     height,
+				Pagination: pagination,
+			})
+		}
+	} else {
+		switch rpc {
+		case "GetNodeInfo":
+			return e.cosmosClient.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+		case "GetSyncing":
+			return e.cosmosClient.GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+		case "GetLatestBlock":
+			return e.cosmosClient.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+		case "GetLatestValidatorSet":
+			return e.cosmosClient.GetLatestValidatorSet(ctx, &tmservice.GetLatestValidatorSetRequest{Pagination: pagination})
+		case "GetBlockByHeight":
+			return e.cosmosClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: height})
+		case "GetValidatorSetByHeight":
+			return e.cosmosClient.GetValidatorSetByHeight(ctx, &tmservice.GetValidatorSetByHeightRequest{
+				Height:     height,
+				Pagination: pagination,
+			})
+		}
+	}
+
+	return nil, errors.Errorf("unknown RPC %q", rpc)
+}
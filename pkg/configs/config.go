@@ -1,6 +1,8 @@
 package configs
 
 import (
+	"time"
+
 	"github.com/joeshaw/envdecode"
 
 	"github.com/pkg/errors"
@@ -8,12 +10,57 @@ import (
 
 // Config represents all HTTP server configuration options.
 type Config struct {
-	ServerName            string `env:"SERVER_NAME"`
-	ServerHost            string `env:"SERVER_HOST"`
-	ServerPort            int    `env:"SERVER_PORT"`
-	LogLevel              string `env:"LOG_LEVEL"`
-	LogFormat             string `env:"LOG_FORMAT"`
-	CosmosSDKGRPCEndpoint string `env:"COSMOS_SDK_GRPC_ENDPOINT"`
+	ServerName               string        `env:"SERVER_NAME"`
+	ServerHost               string        `env:"SERVER_HOST"`
+	ServerPort               int           `env:"SERVER_PORT"`
+	AdminServerHost          string        `env:"ADMIN_SERVER_HOST,default=localhost"`
+	AdminServerPort          int           `env:"ADMIN_SERVER_PORT,default=8081"`
+	LogLevel                 string        `env:"LOG_LEVEL"`
+	LogFormat                string        `env:"LOG_FORMAT"`
+	LogToStdout              bool          `env:"LOG_TO_STDOUT,default=true"`
+	LogFilePath              string        `env:"LOG_FILE_PATH"`
+	LogFileFormat            string        `env:"LOG_FILE_FORMAT,default=json"`
+	LogFileLevel             string        `env:"LOG_FILE_LEVEL,default=debug"`
+	LogFileMaxSizeMB         int           `env:"LOG_FILE_MAX_SIZE_MB,default=100"`
+	LogFileMaxAgeDays        int           `env:"LOG_FILE_MAX_AGE_DAYS,default=7"`
+	LogFileMaxBackups        int           `env:"LOG_FILE_MAX_BACKUPS,default=5"`
+	LogFileCompress          bool          `env:"LOG_FILE_COMPRESS,default=false"`
+	LogSyslogEnabled         bool          `env:"LOG_SYSLOG_ENABLED,default=false"`
+	LogSyslogTag             string        `env:"LOG_SYSLOG_TAG,default=cosmos-grpc-forwarder"`
+	LogSyslogFormat          string        `env:"LOG_SYSLOG_FORMAT,default=json"`
+	LogSyslogLevel           string        `env:"LOG_SYSLOG_LEVEL,default=debug"`
+	AccessLogEnabled         bool          `env:"ACCESS_LOG_ENABLED,default=false"`
+	AccessLogFilePath        string        `env:"ACCESS_LOG_FILE_PATH"`
+	AccessLogFormat          string        `env:"ACCESS_LOG_FORMAT,default=json"`
+	AccessLogLevel           string        `env:"ACCESS_LOG_LEVEL,default=info"`
+	JSONMode                 string        `env:"JSON_MODE,default=default"`
+	LogBodies                bool          `env:"LOG_BODIES,default=true"`
+	LogMaxBodyBytes          int           `env:"LOG_MAX_BODY_BYTES,default=0"`
+	LogSampleRate            float64       `env:"LOG_SAMPLE_RATE,default=1"`
+	LogRedactHeaders         []string      `env:"LOG_REDACT_HEADERS,default=authorization;cookie;x-api-key"`
+	LogMethodPolicies        []string      `env:"LOG_METHOD_POLICIES"`
+	CosmosSDKGRPCEndpoint    string        `env:"COSMOS_SDK_GRPC_ENDPOINT"`
+	Chains                   []string      `env:"CHAINS"`
+	DefaultChainID           string        `env:"DEFAULT_CHAIN_ID"`
+	CosmosSDKVersionRange    string        `env:"COSMOS_SDK_VERSION_RANGE"`
+	UpstreamCheckInterval    time.Duration `env:"UPSTREAM_CHECK_INTERVAL,default=5m"`
+	UpstreamRecordDir        string        `env:"UPSTREAM_RECORD_DIR"`
+	ShadowUpstream           string        `env:"SHADOW_UPSTREAM"`
+	ShadowChainID            string        `env:"SHADOW_CHAIN_ID"`
+	ShadowPercent            float64       `env:"SHADOW_PERCENT,default=0"`
+	ShadowMaxInFlight        int           `env:"SHADOW_MAX_IN_FLIGHT,default=16"`
+	ShadowTimeout            time.Duration `env:"SHADOW_TIMEOUT,default=10s"`
+	RPCProxyHost             string        `env:"RPC_PROXY_HOST,default=localhost"`
+	RPCProxyPort             int           `env:"RPC_PROXY_PORT,default=8082"`
+	CometBFTRPCUpstreams     []string      `env:"COMETBFT_RPC_UPSTREAMS"`
+	BlockRangeMaxConcurrency int           `env:"BLOCK_RANGE_MAX_CONCURRENCY,default=8"`
+	BlockRangeMaxBlocks      int64         `env:"BLOCK_RANGE_MAX_BLOCKS,default=10000"`
+	TxDedupeWindow           time.Duration `env:"TX_DEDUPE_WINDOW,default=1m"`
+	IndexerEnabled           bool          `env:"INDEXER_ENABLED,default=false"`
+	IndexerDBPath            string        `env:"INDEXER_DB_PATH,default=data/index.db"`
+	IndexerChainID           string        `env:"INDEXER_CHAIN_ID"`
+	IndexerStartHeight       int64         `env:"INDEXER_START_HEIGHT,default=0"`
+	IndexerPollInterval      time.Duration `env:"INDEXER_POLL_INTERVAL,default=5s"`
 }
 
 // NewConfig constructs a new instance of ServerConfig via decoding
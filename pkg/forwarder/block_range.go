@@ -0,0 +1,128 @@
+package forwarder
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+// BlockRangeLimits bounds the work done by a single GetBlockRange call.
+type BlockRangeLimits struct {
+	// MaxConcurrency is the maximum number of blocks fetched at the same time.
+	MaxConcurrency int
+	// MaxBlocks is the maximum number of blocks in a single range. Zero means no limit.
+	MaxBlocks int64
+}
+
+// blockResult is the outcome of fetching a single block.
+type blockResult struct {
+	resp *pb.GetBlockRangeResponse
+	err  error
+}
+
+// GetBlockRange streams the blocks of a height range in height order. Blocks are fetched with
+// bounded concurrency, each one from the next healthy upstream of the selected chain, and are
+// delivered as soon as all blocks below them have been sent.
+func (h *ServiceHandler) GetBlockRange(req *pb.GetBlockRangeRequest, stream pb.Service_GetBlockRangeServer) error {
+	if req.FromHeight <= 0 || req.ToHeight < req.FromHeight {
+		return status.Errorf(codes.InvalidArgument,
+			"invalid block range [%d, %d]: heights must be positive and from_height <= to_height",
+			req.FromHeight, req.ToHeight)
+	}
+
+	if count := req.ToHeight - req.FromHeight + 1; h.blockRange.MaxBlocks > 0 && count > h.blockRange.MaxBlocks {
+		return status.Errorf(codes.InvalidArgument,
+			"block range of %d blocks exceeds the limit of %d blocks", count, h.blockRange.MaxBlocks)
+	}
+
+	concurrency := h.blockRange.MaxConcurrency
+	if req.Concurrency > 0 && int(req.Concurrency) < concurrency {
+		concurrency = int(req.Concurrency)
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// pending holds one result channel per height in height order. Its capacity bounds the number
+	// of blocks waiting to be sent, while slots bounds the number of blocks being fetched.
+	pending := make(chan chan blockResult, concurrency)
+	slots := make(chan struct{}, concurrency)
+
+	go func() {
+		defer close(pending)
+
+		for height := req.FromHeight; height <= req.ToHeight; height++ {
+			result := make(chan blockResult, 1)
+
+			select {
+			case pending <- result:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(height int64) {
+				defer func() { <-slots }()
+
+				resp, err := h.fetchBlock(ctx, height)
+				result <- blockResult{resp: resp, err: err}
+			}(height)
+		}
+	}()
+
+	for result := range pending {
+		var r blockResult
+
+		select {
+		case r = <-result:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if r.err != nil {
+			return r.err
+		}
+
+		if err := stream.Send(r.resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchBlock fetches a single block of a range. The forwarder has no response cache yet, so it
+// always goes to an upstream. A cache for immutable blocks would plug in here.
+func (h *ServiceHandler) fetchBlock(ctx context.Context, height int64) (*pb.GetBlockRangeResponse, error) {
+	serviceClient, err := h.serviceClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := serviceClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, h.upstreamError(ctx, "GetBlockByHeight", err)
+	}
+
+	return &pb.GetBlockRangeResponse{
+		Height:   height,
+		BlockId:  resp.GetBlockId(),
+		Block:    resp.GetBlock(),
+		SdkBlock: remapSDKBlock(resp.GetSdkBlock()),
+	}, nil
+}
@@ -0,0 +1,133 @@
+package forwarder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+)
+
+func TestGetBlockRangeInOrder(t *testing.T) {
+	fake := testrunner.NewFakeUpstream("test-1", 50, 1)
+	fake.SetLatency(time.Millisecond)
+	serviceClient := newTestServiceClient(t, fake.Conn(t))
+
+	stream, err := serviceClient.GetBlockRange(context.Background(), &pb.GetBlockRangeRequest{
+		FromHeight: 1,
+		ToHeight:   50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := int64(1)
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if want != 51 {
+				t.Fatalf("stream ended at height %d: %v", want, err)
+			}
+
+			break
+		}
+
+		if resp.Height != want || resp.SdkBlock.Header.Height != want {
+			t.Fatalf("expected height %d, got %d", want, resp.Height)
+		}
+
+		want++
+	}
+
+	// The handler is limited to 4 concurrent fetches.
+	if fake.MaxInFlight() > 4 {
+		t.Errorf("expected at most 4 blocks in flight, got %d", fake.MaxInFlight())
+	}
+}
+
+func TestGetBlockRangeRequestedConcurrency(t *testing.T) {
+	fake := testrunner.NewFakeUpstream("test-1", 30, 1)
+	fake.SetLatency(time.Millisecond)
+	serviceClient := newTestServiceClient(t, fake.Conn(t))
+
+	stream, err := serviceClient.GetBlockRange(context.Background(), &pb.GetBlockRangeRequest{
+		FromHeight:  1,
+		ToHeight:    30,
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := 0
+
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+
+		received++
+	}
+
+	if received != 30 {
+		t.Fatalf("expected 30 blocks, got %d", received)
+	}
+
+	if fake.MaxInFlight() > 2 {
+		t.Errorf("expected at most 2 blocks in flight, got %d", fake.MaxInFlight())
+	}
+}
+
+func TestGetBlockRangeInvalid(t *testing.T) {
+	serviceClient := newTestServiceClient(t, testrunner.NewFakeUpstream("test-1", 50, 1).Conn(t))
+
+	for _, req := range []*pb.GetBlockRangeRequest{
+		{FromHeight: 0, ToHeight: 10},
+		{FromHeight: 10, ToHeight: 9},
+	} {
+		stream, err := serviceClient.GetBlockRange(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument for [%d, %d], got %v", req.FromHeight, req.ToHeight, err)
+		}
+	}
+}
+
+func TestFetchBlockRangeResumes(t *testing.T) {
+	fake := testrunner.NewFakeUpstream("test-1", 30, 1)
+	fake.FailBlock(12, 1)
+	fake.FailBlock(25, 2)
+	serviceClient := newTestServiceClient(t, fake.Conn(t))
+
+	var heights []int64
+
+	err := client.FetchBlockRange(context.Background(), serviceClient, 1, 30, 3,
+		func(resp *pb.GetBlockRangeResponse) error {
+			heights = append(heights, resp.Height)
+
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(heights) != 30 {
+		t.Fatalf("expected 30 blocks, got %d", len(heights))
+	}
+
+	for i, h := range heights {
+		if h != int64(i+1) {
+			t.Fatalf("expected height %d at position %d, got %d", i+1, i, h)
+		}
+	}
+}
@@ -0,0 +1,618 @@
+package forwarder_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	cmtp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+)
+
+const _goldenDir = "testdata/golden"
+
+var _update = flag.Bool("update", false, "rewrite the golden files in "+_goldenDir+" from the current output")
+
+// _conformanceTime is the block time of all conformance blocks, so that golden files are stable.
+var _conformanceTime = time.Date(2023, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+// conformanceConn is an upstream answering every call with the message returned by respond.
+// The message goes through proto encoding like on the wire, so that unknown or unset fields
+// behave as they do against a real node.
+type conformanceConn struct {
+	respond func(req any) (proto.Message, error)
+}
+
+func (c *conformanceConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	resp, err := c.respond(args)
+	if err != nil {
+		return err
+	}
+
+	b, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(b, reply.(proto.Message))
+}
+
+func (c *conformanceConn) NewStream(
+	ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "unexpected method %s", method)
+}
+
+// conformanceCase calls one RPC and returns all response messages in order.
+type conformanceCase struct {
+	name    string
+	method  string
+	respond func(req any) (proto.Message, error)
+	call    func(ctx context.Context, client pb.ServiceClient) ([]proto.Message, error)
+}
+
+func TestConformance(t *testing.T) {
+	cases := []conformanceCase{
+		{
+			name:   "GetNodeInfo",
+			method: "GetNodeInfo",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{})
+			}),
+		},
+		{
+			name:   "GetNodeInfo_empty_build_deps",
+			method: "GetNodeInfo",
+			respond: func(req any) (proto.Message, error) {
+				return &tmservice.GetNodeInfoResponse{
+					DefaultNodeInfo:    conformanceNodeInfo(),
+					ApplicationVersion: &tmservice.VersionInfo{AppName: "osmosisd", CosmosSdkVersion: "v0.47.2"},
+				}, nil
+			},
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{})
+			}),
+		},
+		{
+			name:   "GetNodeInfo_nil_application_version",
+			method: "GetNodeInfo",
+			respond: func(req any) (proto.Message, error) {
+				return &tmservice.GetNodeInfoResponse{DefaultNodeInfo: conformanceNodeInfo()}, nil
+			},
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{})
+			}),
+		},
+		{
+			name:   "GetSyncing",
+			method: "GetSyncing",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetSyncing(ctx, &pb.GetSyncingRequest{})
+			}),
+		},
+		{
+			name:   "GetLatestBlock",
+			method: "GetLatestBlock",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{})
+			}),
+		},
+		{
+			name:   "GetLatestBlock_nil_sdk_block",
+			method: "GetLatestBlock",
+			respond: func(req any) (proto.Message, error) {
+				blockID, block, _ := conformanceBlock(20)
+
+				return &tmservice.GetLatestBlockResponse{BlockId: blockID, Block: block}, nil
+			},
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{})
+			}),
+		},
+		{
+			name:   "GetBlockByHeight",
+			method: "GetBlockByHeight",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: 10})
+			}),
+		},
+		{
+			name:   "GetBlockByHeight_nil_sdk_block",
+			method: "GetBlockByHeight",
+			respond: func(req any) (proto.Message, error) {
+				blockID, block, _ := conformanceBlock(req.(*tmservice.GetBlockByHeightRequest).Height)
+
+				return &tmservice.GetBlockByHeightResponse{BlockId: blockID, Block: block}, nil
+			},
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: 10})
+			}),
+		},
+		{
+			name:   "GetDecodedBlockByHeight",
+			method: "GetDecodedBlockByHeight",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetDecodedBlockByHeight(ctx, &pb.GetDecodedBlockByHeightRequest{Height: 10})
+			}),
+		},
+		{
+			name:   "GetLatestValidatorSet",
+			method: "GetLatestValidatorSet",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetLatestValidatorSet(ctx, &pb.GetLatestValidatorSetRequest{
+					Pagination: &query.PageRequest{Limit: 2},
+				})
+			}),
+		},
+		{
+			name:   "GetValidatorSetByHeight",
+			method: "GetValidatorSetByHeight",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetValidatorSetByHeight(ctx, &pb.GetValidatorSetByHeightRequest{
+					Height:     10,
+					Pagination: &query.PageRequest{Offset: 1, Limit: 10},
+				})
+			}),
+		},
+		{
+			name:   "ABCIQuery",
+			method: "ABCIQuery",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.ABCIQuery(ctx, &pb.ABCIQueryRequest{Path: "/store/bank/key", Data: []byte("key"), Height: 10, Prove: true})
+			}),
+		},
+		{
+			name:   "ABCIQuery_nil_proof_ops",
+			method: "ABCIQuery",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.ABCIQuery(ctx, &pb.ABCIQueryRequest{Path: "/store/bank/key", Data: []byte("key"), Height: 10})
+			}),
+		},
+		{
+			name:   "GetFullValidatorSet",
+			method: "GetFullValidatorSet",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetFullValidatorSet(ctx, &pb.GetFullValidatorSetRequest{PageSize: 2})
+			}),
+		},
+		{
+			name:   "StreamValidatorSet",
+			method: "StreamValidatorSet",
+			call: func(ctx context.Context, c pb.ServiceClient) ([]proto.Message, error) {
+				stream, err := c.StreamValidatorSet(ctx, &pb.GetFullValidatorSetRequest{Height: 10, PageSize: 2})
+				if err != nil {
+					return nil, err
+				}
+
+				return receiveAll(func() (proto.Message, error) { return stream.Recv() })
+			},
+		},
+		{
+			name:   "GetValidatorSetDiff",
+			method: "GetValidatorSetDiff",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetValidatorSetDiff(ctx, &pb.GetValidatorSetDiffRequest{FromHeight: 10, ToHeight: 20})
+			}),
+		},
+		{
+			name:   "GetBlockRange",
+			method: "GetBlockRange",
+			call: func(ctx context.Context, c pb.ServiceClient) ([]proto.Message, error) {
+				stream, err := c.GetBlockRange(ctx, &pb.GetBlockRangeRequest{FromHeight: 10, ToHeight: 12})
+				if err != nil {
+					return nil, err
+				}
+
+				return receiveAll(func() (proto.Message, error) { return stream.Recv() })
+			},
+		},
+		{
+			name:   "GetBlockByHash",
+			method: "GetBlockByHash",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				blockID, _, _ := conformanceBlock(10)
+
+				return c.GetBlockByHash(ctx, &pb.GetBlockByHashRequest{Hash: fmt.Sprintf("%X", blockID.Hash)})
+			}),
+		},
+		{
+			name:   "GetTxByHash",
+			method: "GetTxByHash",
+			call: unary(func(ctx context.Context, c pb.ServiceClient) (proto.Message, error) {
+				return c.GetTxByHash(ctx, &pb.GetTxByHashRequest{Hash: indexer.TxHash(conformanceTxs(10)[1])})
+			}),
+		},
+	}
+
+	verifyConformanceCoverage(t, cases)
+
+	ctx := context.Background()
+	index := newConformanceIndex(t)
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			respond := tc.respond
+			if respond == nil {
+				respond = conformanceResponse
+			}
+
+			serviceClient := newIndexedTestServiceClient(t, &conformanceConn{respond: respond}, index)
+
+			msgs, err := tc.call(ctx, serviceClient)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			verifyGolden(t, tc.name, msgs)
+		})
+	}
+}
+
+// verifyConformanceCoverage fails when a method of the forwarder service has no conformance case.
+func verifyConformanceCoverage(t *testing.T, cases []conformanceCase) {
+	t.Helper()
+
+	covered := make(map[string]bool, len(cases))
+	for _, tc := range cases {
+		covered[tc.method] = true
+	}
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterServiceServer(grpcServer, &pb.UnimplementedServiceServer{})
+
+	for _, info := range grpcServer.GetServiceInfo() {
+		for _, m := range info.Methods {
+			if !covered[m.Name] {
+				t.Errorf("no conformance case for %s", m.Name)
+			}
+		}
+	}
+}
+
+// verifyGolden compares the proto encoding of msgs with <name>.pb, holding each message prefixed
+// by its uvarint encoded length. <name>.json holds the same messages as JSON to review changes and
+// to show what differs.
+func verifyGolden(t *testing.T, name string, msgs []proto.Message) {
+	t.Helper()
+
+	jsonConverter := jsonconv.NewJSONConverter()
+
+	var encoded, rendered bytes.Buffer
+
+	for _, msg := range msgs {
+		b, err := proto.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encoded.Write(binary.AppendUvarint(nil, uint64(len(b))))
+		encoded.Write(b)
+
+		j, err := jsonConverter.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rendered.Write(j)
+		rendered.WriteByte('\n')
+	}
+
+	pbPath := filepath.Join(_goldenDir, name+".pb")
+	jsonPath := filepath.Join(_goldenDir, name+".json")
+
+	if *_update {
+		if err := os.MkdirAll(_goldenDir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(pbPath, encoded.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(jsonPath, rendered.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(pbPath)
+	if err != nil {
+		t.Fatalf("%v, create golden files with: go test -run TestConformance -update", err)
+	}
+
+	if !bytes.Equal(encoded.Bytes(), want) {
+		wantJSON, _ := os.ReadFile(jsonPath)
+
+		t.Errorf("proto encoding differs from %s\n%v", pbPath,
+			compare(strings.Split(rendered.String(), "\n"), strings.Split(string(wantJSON), "\n")))
+	}
+}
+
+func unary(call func(ctx context.Context, c pb.ServiceClient) (proto.Message, error),
+) func(ctx context.Context, c pb.ServiceClient) ([]proto.Message, error) {
+	return func(ctx context.Context, c pb.ServiceClient) ([]proto.Message, error) {
+		msg, err := call(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		return []proto.Message{msg}, nil
+	}
+}
+
+func receiveAll(recv func() (proto.Message, error)) ([]proto.Message, error) {
+	var msgs []proto.Message
+
+	for {
+		msg, err := recv()
+		if errors.Is(err, io.EOF) {
+			return msgs, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, msg)
+	}
+}
+
+func newConformanceIndex(t *testing.T) *indexer.Store {
+	t.Helper()
+
+	index, err := indexer.OpenStore(filepath.Join(t.TempDir(), "index.db"), "osmosis-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { index.Close() })
+
+	blockID, _, sdkBlock := conformanceBlock(10)
+	if err := index.PutBlock(indexer.NewIndexedBlock(blockID, nil, sdkBlock)); err != nil {
+		t.Fatal(err)
+	}
+
+	return index
+}
+
+// conformanceResponse answers every upstream call with complete data of a small chain at height 20.
+func conformanceResponse(req any) (proto.Message, error) {
+	const latestHeight = 20
+
+	switch req := req.(type) {
+	case *tmservice.GetNodeInfoRequest:
+		return &tmservice.GetNodeInfoResponse{
+			DefaultNodeInfo: conformanceNodeInfo(),
+			ApplicationVersion: &tmservice.VersionInfo{
+				Name:      "osmosis",
+				AppName:   "osmosisd",
+				Version:   "15.1.0",
+				GitCommit: "6cb6f0c1b1c6e4d2b6a8c6b5b1e1d5f3a9a3c1e2",
+				BuildTags: "netgo,ledger",
+				GoVersion: "go version go1.20.3 linux/amd64",
+				BuildDeps: []*tmservice.Module{
+					{Path: "github.com/cosmos/cosmos-sdk", Version: "v0.47.2", Sum: "h1:sdk="},
+					{Path: "github.com/cometbft/cometbft", Version: "v0.37.1", Sum: "h1:cmt="},
+				},
+				CosmosSdkVersion: "v0.47.2",
+			},
+		}, nil
+	case *tmservice.GetSyncingRequest:
+		return &tmservice.GetSyncingResponse{Syncing: true}, nil
+	case *tmservice.GetLatestBlockRequest:
+		blockID, block, sdkBlock := conformanceBlock(latestHeight)
+
+		return &tmservice.GetLatestBlockResponse{BlockId: blockID, Block: block, SdkBlock: sdkBlock}, nil
+	case *tmservice.GetBlockByHeightRequest:
+		blockID, block, sdkBlock := conformanceBlock(req.Height)
+
+		return &tmservice.GetBlockByHeightResponse{BlockId: blockID, Block: block, SdkBlock: sdkBlock}, nil
+	case *tmservice.GetLatestValidatorSetRequest:
+		validators, page := conformanceValidatorPage(latestHeight, req.Pagination)
+
+		return &tmservice.GetLatestValidatorSetResponse{
+			BlockHeight: latestHeight,
+			Validators:  validators,
+			Pagination:  page,
+		}, nil
+	case *tmservice.GetValidatorSetByHeightRequest:
+		validators, page := conformanceValidatorPage(req.Height, req.Pagination)
+
+		return &tmservice.GetValidatorSetByHeightResponse{
+			BlockHeight: req.Height,
+			Validators:  validators,
+			Pagination:  page,
+		}, nil
+	case *tmservice.ABCIQueryRequest:
+		resp := &tmservice.ABCIQueryResponse{
+			Log:       "exists",
+			Key:       req.Data,
+			Value:     []byte("value"),
+			Height:    req.Height,
+			Codespace: "sdk",
+		}
+
+		if req.Prove {
+			resp.ProofOps = &tmservice.ProofOps{Ops: []tmservice.ProofOp{
+				{Type: "ics23:iavl", Key: req.Data, Data: []byte("iavl-proof")},
+				{Type: "ics23:simple", Key: []byte("bank"), Data: []byte("simple-proof")},
+			}}
+		}
+
+		return resp, nil
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "unexpected request %T", req)
+	}
+}
+
+func conformanceNodeInfo() *cmtp2p.DefaultNodeInfo {
+	return &cmtp2p.DefaultNodeInfo{
+		ProtocolVersion: cmtp2p.ProtocolVersion{P2P: 8, Block: 11},
+		DefaultNodeID:   "5f2f6f3e0e4c1d7a9b8c7d6e5f4a3b2c1d0e9f8a",
+		ListenAddr:      "tcp://0.0.0.0:26656",
+		Network:         "osmosis-1",
+		Version:         "0.37.1",
+		Channels:        []byte{0x40, 0x20, 0x21},
+		Moniker:         "conformance",
+		Other:           cmtp2p.DefaultNodeInfoOther{TxIndex: "off", RPCAddress: "tcp://0.0.0.0:26657"},
+	}
+}
+
+// conformanceBlock returns a block with two transactions, the first of which is a valid Cosmos SDK tx.
+func conformanceBlock(height int64) (*cmtproto.BlockID, *cmtproto.Block, *tmservice.Block) {
+	hash := bytes.Repeat([]byte{byte(height)}, 32)
+	parentHash := bytes.Repeat([]byte{byte(height - 1)}, 32)
+	proposer := bytes.Repeat([]byte{0xAA}, 20)
+
+	blockID := &cmtproto.BlockID{Hash: hash, PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: hash}}
+	lastBlockID := cmtproto.BlockID{Hash: parentHash, PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: parentHash}}
+	data := cmtproto.Data{Txs: conformanceTxs(height)}
+	lastCommit := &cmtproto.Commit{
+		Height:  height - 1,
+		BlockID: lastBlockID,
+		Signatures: []cmtproto.CommitSig{{
+			BlockIdFlag:      cmtproto.BlockIDFlagCommit,
+			ValidatorAddress: proposer,
+			Timestamp:        _conformanceTime,
+			Signature:        []byte("signature"),
+		}},
+	}
+
+	header := cmtproto.Header{
+		ChainID:            "osmosis-1",
+		Height:             height,
+		Time:               _conformanceTime.Add(time.Duration(height) * 5 * time.Second),
+		LastBlockId:        lastBlockID,
+		LastCommitHash:     bytes.Repeat([]byte{0x01}, 32),
+		DataHash:           bytes.Repeat([]byte{0x02}, 32),
+		ValidatorsHash:     bytes.Repeat([]byte{0x03}, 32),
+		NextValidatorsHash: bytes.Repeat([]byte{0x04}, 32),
+		ConsensusHash:      bytes.Repeat([]byte{0x05}, 32),
+		AppHash:            bytes.Repeat([]byte{0x06}, 32),
+		LastResultsHash:    bytes.Repeat([]byte{0x07}, 32),
+		EvidenceHash:       bytes.Repeat([]byte{0x08}, 32),
+		ProposerAddress:    proposer,
+	}
+	header.Version.Block = 11
+	header.Version.App = 15
+
+	sdkBlock := &tmservice.Block{
+		Header: tmservice.Header{
+			Version:            header.Version,
+			ChainID:            header.ChainID,
+			Height:             header.Height,
+			Time:               header.Time,
+			LastBlockId:        header.LastBlockId,
+			LastCommitHash:     header.LastCommitHash,
+			DataHash:           header.DataHash,
+			ValidatorsHash:     header.ValidatorsHash,
+			NextValidatorsHash: header.NextValidatorsHash,
+			ConsensusHash:      header.ConsensusHash,
+			AppHash:            header.AppHash,
+			LastResultsHash:    header.LastResultsHash,
+			EvidenceHash:       header.EvidenceHash,
+			ProposerAddress:    sdk.ConsAddress(proposer).String(),
+		},
+		Data:       data,
+		LastCommit: lastCommit,
+	}
+
+	return blockID, &cmtproto.Block{Header: header, Data: data, LastCommit: lastCommit}, sdkBlock
+}
+
+// conformanceTxs returns a valid Cosmos SDK tx and a tx which cannot be decoded.
+func conformanceTxs(height int64) [][]byte {
+	bodyBytes, err := proto.Marshal(&txtypes.TxBody{Memo: fmt.Sprintf("block %d", height)})
+	if err != nil {
+		panic(err)
+	}
+
+	authInfoBytes, err := proto.Marshal(&txtypes.AuthInfo{
+		Fee: &txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 2500)), GasLimit: 100000},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	txBytes, err := proto.Marshal(&txtypes.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{[]byte("signature")},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return [][]byte{txBytes, {0xff, 0xff, byte(height)}}
+}
+
+// conformanceValidators returns the validator-set at height. The set changes at height 20.
+func conformanceValidators(height int64) []*tmservice.Validator {
+	powers := []int64{300, 200, 100}
+	if height >= 20 {
+		powers = []int64{300, 0, 150, 50}
+	}
+
+	validators := make([]*tmservice.Validator, 0, len(powers))
+
+	for i, power := range powers {
+		if power == 0 {
+			continue
+		}
+
+		pubKey := ed25519.GenPrivKeyFromSecret([]byte{byte(i)}).PubKey()
+
+		pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			panic(err)
+		}
+
+		validators = append(validators, &tmservice.Validator{
+			Address:          sdk.ConsAddress(pubKey.Address()).String(),
+			PubKey:           pubKeyAny,
+			VotingPower:      power,
+			ProposerPriority: int64(i*10) - height,
+		})
+	}
+
+	return validators
+}
+
+func conformanceValidatorPage(height int64, pageReq *query.PageRequest) ([]*tmservice.Validator, *query.PageResponse) {
+	validators := conformanceValidators(height)
+
+	offset := pageReq.GetOffset()
+	if offset > uint64(len(validators)) {
+		offset = uint64(len(validators))
+	}
+
+	end := offset + pageReq.GetLimit()
+	if pageReq.GetLimit() == 0 || end > uint64(len(validators)) {
+		end = uint64(len(validators))
+	}
+
+	return validators[offset:end], &query.PageResponse{Total: uint64(len(validators))}
+}
@@ -0,0 +1,253 @@
+package forwarder_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+// fuzzConn decodes the same fuzzed bytes into every upstream reply. It skips the interface unpacking
+// of the SDK codec, so that malformed Any values reach the handlers.
+type fuzzConn struct {
+	resp []byte
+}
+
+func (c *fuzzConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	if err := proto.Unmarshal(c.resp, reply.(proto.Message)); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (c *fuzzConn) NewStream(
+	ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "unexpected method %s", method)
+}
+
+// fuzzStream collects the messages sent by a server streaming handler.
+type fuzzStream[T any] struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*T
+}
+
+func (s *fuzzStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fuzzStream[T]) Send(m *T) error {
+	s.sent = append(s.sent, m)
+
+	return nil
+}
+
+// FuzzServiceHandler feeds arbitrary request and upstream response bytes through every ServiceHandler
+// RPC and renders the results in every JSON mode. Errors are fine, panics and hangs are not.
+func FuzzServiceHandler(f *testing.F) {
+	for _, resp := range fuzzSeedResponses(f) {
+		f.Add([]byte{}, resp)
+	}
+
+	f.Add(mustMarshal(f, &pb.GetBlockRangeRequest{FromHeight: 1, ToHeight: 3}), []byte{})
+	f.Add(mustMarshal(f, &pb.GetValidatorSetDiffRequest{FromHeight: 1, ToHeight: 2}), []byte{})
+	f.Add(mustMarshal(f, &pb.ABCIQueryRequest{Path: "/store/bank/key", Height: 10, Prove: true}), []byte{})
+
+	interfaceRegistry := registry.NewInterfaceRegistry()
+	logger := log.InitializeLogger("error", "json")
+	converters := fuzzJSONConverters(interfaceRegistry)
+
+	f.Fuzz(func(t *testing.T, reqBytes []byte, respBytes []byte) {
+		ctx := context.Background()
+		handler := forwarder.NewServiceHandler(
+			newTestRouter(&fuzzConn{resp: respBytes}),
+			forwarder.NewTxDecoder(interfaceRegistry),
+			forwarder.BlockRangeLimits{MaxConcurrency: 4, MaxBlocks: 8},
+			nil,
+			logger,
+		)
+
+		var responses []proto.Message
+
+		collect := func(resp proto.Message, err error) {
+			if err == nil {
+				responses = append(responses, resp)
+			}
+		}
+
+		unary := []struct {
+			req  proto.Message
+			call func(req proto.Message) (proto.Message, error)
+		}{
+			{&pb.GetNodeInfoRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetNodeInfo(ctx, req.(*pb.GetNodeInfoRequest))
+			}},
+			{&pb.GetSyncingRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetSyncing(ctx, req.(*pb.GetSyncingRequest))
+			}},
+			{&pb.GetLatestBlockRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetLatestBlock(ctx, req.(*pb.GetLatestBlockRequest))
+			}},
+			{&pb.GetBlockByHeightRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetBlockByHeight(ctx, req.(*pb.GetBlockByHeightRequest))
+			}},
+			{&pb.GetDecodedBlockByHeightRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetDecodedBlockByHeight(ctx, req.(*pb.GetDecodedBlockByHeightRequest))
+			}},
+			{&pb.GetLatestValidatorSetRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetLatestValidatorSet(ctx, req.(*pb.GetLatestValidatorSetRequest))
+			}},
+			{&pb.GetValidatorSetByHeightRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetValidatorSetByHeight(ctx, req.(*pb.GetValidatorSetByHeightRequest))
+			}},
+			{&pb.ABCIQueryRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.ABCIQuery(ctx, req.(*pb.ABCIQueryRequest))
+			}},
+			{&pb.GetFullValidatorSetRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetFullValidatorSet(ctx, req.(*pb.GetFullValidatorSetRequest))
+			}},
+			{&pb.GetValidatorSetDiffRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetValidatorSetDiff(ctx, req.(*pb.GetValidatorSetDiffRequest))
+			}},
+			{&pb.GetBlockByHashRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetBlockByHash(ctx, req.(*pb.GetBlockByHashRequest))
+			}},
+			{&pb.GetTxByHashRequest{}, func(req proto.Message) (proto.Message, error) {
+				return handler.GetTxByHash(ctx, req.(*pb.GetTxByHashRequest))
+			}},
+		}
+
+		for _, u := range unary {
+			if proto.Unmarshal(reqBytes, u.req) != nil {
+				continue
+			}
+
+			collect(u.call(u.req))
+		}
+
+		blockRangeReq := &pb.GetBlockRangeRequest{}
+		if proto.Unmarshal(reqBytes, blockRangeReq) == nil {
+			stream := &fuzzStream[pb.GetBlockRangeResponse]{ctx: ctx}
+			_ = handler.GetBlockRange(blockRangeReq, stream)
+
+			for _, m := range stream.sent {
+				responses = append(responses, m)
+			}
+		}
+
+		validatorSetReq := &pb.GetFullValidatorSetRequest{}
+		if proto.Unmarshal(reqBytes, validatorSetReq) == nil {
+			stream := &fuzzStream[pb.StreamValidatorSetResponse]{ctx: ctx}
+			_ = handler.StreamValidatorSet(validatorSetReq, stream)
+
+			for _, m := range stream.sent {
+				responses = append(responses, m)
+			}
+		}
+
+		for _, resp := range responses {
+			if _, err := proto.Marshal(resp); err != nil {
+				t.Errorf("%T does not marshal: %v", resp, err)
+			}
+
+			for _, converter := range converters {
+				_, _ = converter.Marshal(resp)
+			}
+		}
+	})
+}
+
+// fuzzSeedResponses returns upstream responses of the fake upstream and edge cases the forwarder must
+// survive: absent application versions and blocks, and validators with malformed or unknown pubkeys.
+func fuzzSeedResponses(f *testing.F) [][]byte {
+	f.Helper()
+
+	ctx := context.Background()
+	fake := testrunner.NewFakeUpstream("fuzz-1", 10, 3)
+
+	nodeInfo, err := fake.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	block, err := fake.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	validators, err := fake.GetValidatorSetByHeight(ctx, &tmservice.GetValidatorSetByHeightRequest{
+		Height:     5,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	return [][]byte{
+		mustMarshal(f, nodeInfo),
+		mustMarshal(f, block),
+		mustMarshal(f, validators),
+		mustMarshal(f, &tmservice.GetNodeInfoResponse{DefaultNodeInfo: nodeInfo.DefaultNodeInfo}),
+		mustMarshal(f, &tmservice.GetBlockByHeightResponse{BlockId: block.BlockId}),
+		mustMarshal(f, &tmservice.GetBlockByHeightResponse{SdkBlock: &tmservice.Block{}}),
+		mustMarshal(f, &tmservice.GetValidatorSetByHeightResponse{
+			BlockHeight: 5,
+			Validators: []*tmservice.Validator{
+				{
+					Address: "cosmosvalcons1",
+					PubKey:  &codectypes.Any{TypeUrl: "/cosmos.crypto.ed25519.PubKey", Value: []byte{0xff, 0x01}},
+				},
+				{
+					Address: "cosmosvalcons2",
+					PubKey:  &codectypes.Any{TypeUrl: "/unknown.PubKey", Value: []byte("garbage")},
+				},
+			},
+			Pagination: &query.PageResponse{NextKey: []byte("next"), Total: 1 << 40},
+		}),
+		mustMarshal(f, &tmservice.ABCIQueryResponse{
+			Key:      []byte("key"),
+			Value:    []byte("value"),
+			ProofOps: &tmservice.ProofOps{Ops: []tmservice.ProofOp{{Type: "ics23:iavl", Key: []byte("key")}}},
+			Height:   10,
+		}),
+	}
+}
+
+func fuzzJSONConverters(interfaceRegistry codectypes.InterfaceRegistry) []*jsonconv.JSONConverter {
+	modes := []jsonconv.Mode{jsonconv.ModeDefault, jsonconv.ModeProto3, jsonconv.ModeAmino, jsonconv.ModeCanonical}
+	converters := make([]*jsonconv.JSONConverter, 0, 2*len(modes))
+
+	for _, mode := range modes {
+		converters = append(converters,
+			jsonconv.NewJSONConverter(jsonconv.WithMode(mode)),
+			jsonconv.NewJSONConverter(jsonconv.WithMode(mode), jsonconv.WithInterfaceRegistry(interfaceRegistry)),
+		)
+	}
+
+	return converters
+}
+
+func mustMarshal(f *testing.F, msg proto.Message) []byte {
+	f.Helper()
+
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	return b
+}
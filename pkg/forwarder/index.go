@@ -0,0 +1,83 @@
+package forwarder
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// GetBlockByHash looks up a block by its hex encoded hash in the local index.
+func (h *ServiceHandler) GetBlockByHash(
+	ctx context.Context, req *pb.GetBlockByHashRequest) (*pb.GetBlockByHashResponse, error) {
+	if err := h.checkIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	block, err := h.index.BlockByHash(req.Hash)
+	if err != nil {
+		return nil, indexError(err)
+	}
+
+	return &pb.GetBlockByHashResponse{
+		Block: block,
+	}, nil
+}
+
+// GetTxByHash looks up the block height and position of a tx by its hex encoded hash in the local index.
+func (h *ServiceHandler) GetTxByHash(ctx context.Context, req *pb.GetTxByHashRequest) (*pb.GetTxByHashResponse, error) {
+	if err := h.checkIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	loc, err := h.index.TxByHash(req.Hash)
+	if err != nil {
+		return nil, indexError(err)
+	}
+
+	block, err := h.index.BlockByHeight(loc.Height)
+	if err != nil {
+		return nil, indexError(err)
+	}
+
+	if int(loc.Index) >= len(block.TxHashes) {
+		return nil, status.Errorf(codes.Internal, "tx index %d out of range in block %d", loc.Index, loc.Height)
+	}
+
+	return &pb.GetTxByHashResponse{
+		Hash:    block.TxHashes[loc.Index],
+		Height:  loc.Height,
+		Index:   loc.Index,
+		BlockId: block.BlockId,
+	}, nil
+}
+
+// checkIndex fails calls when the indexer is disabled or the call selects a chain that is not indexed.
+func (h *ServiceHandler) checkIndex(ctx context.Context) error {
+	if h.index == nil {
+		return status.Error(codes.FailedPrecondition, "local index is disabled")
+	}
+
+	if chainID := upstream.ChainIDFromContext(ctx); chainID != "" && chainID != h.index.ChainID() {
+		return status.Errorf(codes.NotFound, "chain %q is not indexed", chainID)
+	}
+
+	return nil
+}
+
+func indexError(err error) error {
+	if errors.Is(err, indexer.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if errors.Is(err, indexer.ErrInvalidHash) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
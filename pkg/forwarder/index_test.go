@@ -0,0 +1,66 @@
+package forwarder_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+func TestGetTxByHash(t *testing.T) {
+	ctx := context.Background()
+	logger := log.InitializeLogger("error", "json")
+
+	store, err := indexer.OpenStore(filepath.Join(t.TempDir(), "index.db"), "test-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	block := indexer.NewIndexedBlock(&cmtproto.BlockID{Hash: []byte{0x01}}, nil, &tmservice.Block{
+		Header: tmservice.Header{Height: 10},
+		Data:   cmtproto.Data{Txs: [][]byte{[]byte("a"), []byte("b")}},
+	})
+	if err := store.PutBlock(block); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := forwarder.NewServiceHandler(nil, nil, forwarder.BlockRangeLimits{}, store, logger)
+
+	resp, err := handler.GetTxByHash(ctx, &pb.GetTxByHashRequest{Hash: indexer.TxHash([]byte("b"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Height != 10 || resp.Index != 1 || resp.Hash != indexer.TxHash([]byte("b")) {
+		t.Errorf("unexpected response %+v", resp)
+	}
+
+	disabled := forwarder.NewServiceHandler(nil, nil, forwarder.BlockRangeLimits{}, nil, logger)
+
+	for name, tc := range map[string]struct {
+		handler *forwarder.ServiceHandler
+		hash    string
+		code    codes.Code
+	}{
+		"unknown":  {handler: handler, hash: indexer.TxHash([]byte("c")), code: codes.NotFound},
+		"invalid":  {handler: handler, hash: "not-hex", code: codes.InvalidArgument},
+		"disabled": {handler: disabled, hash: indexer.TxHash([]byte("b")), code: codes.FailedPrecondition},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.handler.GetTxByHash(ctx, &pb.GetTxByHashRequest{Hash: tc.hash})
+			if status.Code(err) != tc.code {
+				t.Errorf("expected %s, got %v", tc.code, err)
+			}
+		})
+	}
+}
@@ -3,28 +3,59 @@ package forwarder
 import (
 	"context"
 
-	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
-	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/server"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/shadow"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
 )
 
 // InitializeGRPCHandlers registers all gRPC handlers to the gRPC server and wires their dependencies.
 func InitializeGRPCHandlers(
 	ctx context.Context,
-	cosmosSDKGRPCEndpoint string,
+	conf *configs.Config,
 	grpcServer *server.Server,
 	logger log.Logger,
 	jsonConverter *jsonconv.JSONConverter,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	mirror *shadow.Mirror,
 ) {
-	grpcConn, err := client.NewDefaultGRPCConn(ctx, logger, jsonConverter, cosmosSDKGRPCEndpoint)
-	if err != nil {
-		logger.Panic("error: cannot create gRPC connection to Cosmos SDK endpoint: ", log.Error(err))
-	}
-	// defer grpcConn.Close()
+	router := upstream.InitializeRouter(ctx, conf, logger, jsonConverter, interfaceRegistry, mirror)
 
-	serviceServer := NewServiceHandler(tmservice.NewServiceClient(grpcConn))
+	RegisterGRPCHandlers(ctx, conf, grpcServer, router, logger, interfaceRegistry)
+}
+
+// RegisterGRPCHandlers registers all gRPC handlers to the gRPC server, forwarding to the upstreams of router.
+func RegisterGRPCHandlers(
+	ctx context.Context,
+	conf *configs.Config,
+	grpcServer *server.Server,
+	router *upstream.Router,
+	logger log.Logger,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) {
+	serviceServer := NewServiceHandler(
+		router,
+		NewTxDecoder(interfaceRegistry),
+		BlockRangeLimits{
+			MaxConcurrency: conf.BlockRangeMaxConcurrency,
+			MaxBlocks:      conf.BlockRangeMaxBlocks,
+		},
+		indexer.InitializeIndexer(ctx, conf, router, logger.Named("indexer")),
+		logger.Named("forwarder"),
+	)
 	pb.RegisterServiceServer(grpcServer.Instance(), serviceServer)
+
+	txServiceServer := NewTxServiceHandler(
+		router,
+		NewBroadcastDedupe(conf.TxDedupeWindow),
+		logger.Named("forwarder"),
+		logger.Named("audit"),
+	)
+	txtypes.RegisterServiceServer(grpcServer.Instance(), txServiceServer)
 }
@@ -0,0 +1,127 @@
+package forwarder
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+// The api.cosmos.forwarder.v1 messages mirror the cosmos.base.tendermint.v1beta1 messages of the
+// Cosmos SDK. Instead of copying upstream responses field by field, they are reinterpreted in place
+// where the generated Go types match, so that large blocks and validator sets are passed through
+// without copying.
+//
+// The conversions below only compile while the types are identical apart from struct tags. They
+// guard the pointer conversions of the messages and slices built from them.
+var (
+	_ = pb.Header(tmservice.Header{})
+	_ = pb.Validator(tmservice.Validator{})
+	_ = pb.Module(tmservice.Module{})
+	_ = pb.ProofOp(tmservice.ProofOp{})
+)
+
+// Block and ProofOps embed the types above, so they are not convertible in Go even though their
+// layouts are identical. Their layouts are compared once at start-up; responses are copied field by
+// field if they ever diverge.
+var (
+	_blockLayoutMatches    = sameLayout(reflect.TypeOf(pb.Block{}), reflect.TypeOf(tmservice.Block{}))
+	_proofOpsLayoutMatches = sameLayout(reflect.TypeOf(pb.ProofOps{}), reflect.TypeOf(tmservice.ProofOps{}))
+)
+
+// sameLayout checks if values of type a can be reinterpreted as values of type b: both have the
+// same size and kind, and structs have the same field names and offsets with recursively matching
+// types. Interfaces, functions and channels only match themselves.
+func sameLayout(a, b reflect.Type) bool {
+	if a == b {
+		return true
+	}
+
+	if a.Kind() != b.Kind() || a.Size() != b.Size() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		if a.NumField() != b.NumField() {
+			return false
+		}
+
+		for i := 0; i < a.NumField(); i++ {
+			fa, fb := a.Field(i), b.Field(i)
+
+			if fa.Name != fb.Name || fa.Offset != fb.Offset || !sameLayout(fa.Type, fb.Type) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Pointer, reflect.Slice:
+		return sameLayout(a.Elem(), b.Elem())
+	case reflect.Array:
+		return a.Len() == b.Len() && sameLayout(a.Elem(), b.Elem())
+	case reflect.Map:
+		return sameLayout(a.Key(), b.Key()) && sameLayout(a.Elem(), b.Elem())
+	case reflect.Interface, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return false
+	default:
+		return true
+	}
+}
+
+// remapValidators passes the upstream validators through. The slice header is reinterpreted,
+// so the result shares its backing array with resp.
+func remapValidators(resp []*tmservice.Validator) []*pb.Validator {
+	if len(resp) == 0 {
+		return []*pb.Validator{}
+	}
+
+	return unsafe.Slice((**pb.Validator)(unsafe.Pointer(unsafe.SliceData(resp))), len(resp))
+}
+
+// remapBuildDeps passes the upstream modules through, sharing the backing array with resp.
+func remapBuildDeps(resp []*tmservice.Module) []*pb.Module {
+	if len(resp) == 0 {
+		return []*pb.Module{}
+	}
+
+	return unsafe.Slice((**pb.Module)(unsafe.Pointer(unsafe.SliceData(resp))), len(resp))
+}
+
+func remapProofOps(resp *tmservice.ProofOps) *pb.ProofOps {
+	if resp == nil {
+		return nil
+	}
+
+	if _proofOpsLayoutMatches {
+		return (*pb.ProofOps)(unsafe.Pointer(resp))
+	}
+
+	proofOps := make([]pb.ProofOp, 0, len(resp.Ops))
+	for _, p := range resp.Ops {
+		proofOps = append(proofOps, pb.ProofOp(p))
+	}
+
+	return &pb.ProofOps{
+		Ops: proofOps,
+	}
+}
+
+func remapSDKBlock(resp *tmservice.Block) *pb.Block {
+	if resp == nil {
+		return nil
+	}
+
+	if _blockLayoutMatches {
+		return (*pb.Block)(unsafe.Pointer(resp))
+	}
+
+	return &pb.Block{
+		Header:     pb.Header(resp.Header),
+		Data:       resp.GetData(),
+		Evidence:   resp.GetEvidence(),
+		LastCommit: resp.GetLastCommit(),
+	}
+}
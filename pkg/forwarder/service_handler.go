@@ -6,29 +6,53 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 
 	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/shadow"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
 )
 
 // ServiceHandler implements api.cosmos.forwarder.v1.Service gRPC service.
 type ServiceHandler struct {
-	ServiceGRPCClient tmservice.ServiceClient
+	Router     *upstream.Router
+	txDecoder  *TxDecoder
+	blockRange BlockRangeLimits
+	index      *indexer.Store
+	logger     log.Logger
 	*pb.UnimplementedServiceServer
 }
 
 // NewServiceHandler is a constructor function for ServiceHandler.
-func NewServiceHandler(client tmservice.ServiceClient) *ServiceHandler {
+func NewServiceHandler(
+	router *upstream.Router,
+	txDecoder *TxDecoder,
+	blockRange BlockRangeLimits,
+	index *indexer.Store,
+	logger log.Logger,
+) *ServiceHandler {
 	return &ServiceHandler{
-		ServiceGRPCClient:          client,
+		Router:                     router,
+		txDecoder:                  txDecoder,
+		blockRange:                 blockRange,
+		index:                      index,
+		logger:                     logger,
 		UnimplementedServiceServer: &pb.UnimplementedServiceServer{},
 	}
 }
 
 // GetNodeInfo queries the current node info.
 func (h *ServiceHandler) GetNodeInfo(ctx context.Context, req *pb.GetNodeInfoRequest) (*pb.GetNodeInfoResponse, error) {
-	resp, err := h.ServiceGRPCClient.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	serviceClient, err := h.serviceClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp, err := serviceClient.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return nil, h.upstreamError(ctx, "GetNodeInfo", err)
+	}
+
 	appVersion := resp.GetApplicationVersion()
 
 	return &pb.GetNodeInfoResponse{
@@ -48,11 +72,16 @@ func (h *ServiceHandler) GetNodeInfo(ctx context.Context, req *pb.GetNodeInfoReq
 
 // GetSyncing queries node syncing.
 func (h *ServiceHandler) GetSyncing(ctx context.Context, req *pb.GetSyncingRequest) (*pb.GetSyncingResponse, error) {
-	resp, err := h.ServiceGRPCClient.GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+	serviceClient, err := h.serviceClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp, err := serviceClient.GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+	if err != nil {
+		return nil, h.upstreamError(ctx, "GetSyncing", err)
+	}
+
 	return &pb.GetSyncingResponse{
 		Syncing: resp.Syncing,
 	}, nil
@@ -61,11 +90,16 @@ func (h *ServiceHandler) GetSyncing(ctx context.Context, req *pb.GetSyncingReque
 // GetLatestBlock returns the latest block.
 func (h *ServiceHandler) GetLatestBlock(
 	ctx context.Context, req *pb.GetLatestBlockRequest) (*pb.GetLatestBlockResponse, error) {
-	resp, err := h.ServiceGRPCClient.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	serviceClient, err := h.serviceClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	resp, err := serviceClient.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return nil, h.upstreamError(ctx, "GetLatestBlock", err)
+	}
+
 	return &pb.GetLatestBlockResponse{
 		BlockId:  resp.GetBlockId(),
 		Block:    resp.GetBlock(),
@@ -76,11 +110,16 @@ func (h *ServiceHandler) GetLatestBlock(
 // GetBlockByHeight queries block for given height.
 func (h *ServiceHandler) GetBlockByHeight(
 	ctx context.Context, req *pb.GetBlockByHeightRequest) (*pb.GetBlockByHeightResponse, error) {
-	resp, err := h.ServiceGRPCClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{
+	serviceClient, err := h.serviceClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := serviceClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{
 		Height: req.Height,
 	})
 	if err != nil {
-		return nil, err
+		return nil, h.upstreamError(ctx, "GetBlockByHeight", err)
 	}
 
 	return &pb.GetBlockByHeightResponse{
@@ -90,14 +129,51 @@ func (h *ServiceHandler) GetBlockByHeight(
 	}, nil
 }
 
+// GetDecodedBlockByHeight queries block for given height and decodes its transactions.
+func (h *ServiceHandler) GetDecodedBlockByHeight(
+	ctx context.Context, req *pb.GetDecodedBlockByHeightRequest) (*pb.GetDecodedBlockByHeightResponse, error) {
+	serviceClient, err := h.serviceClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := serviceClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{
+		Height: req.Height,
+	})
+	if err != nil {
+		return nil, h.upstreamError(ctx, "GetBlockByHeight", err)
+	}
+
+	txs := resp.GetSdkBlock().GetData().Txs
+	if len(txs) == 0 {
+		txs = resp.GetBlock().GetData().Txs
+	}
+
+	decodedTxs := make([]*pb.DecodedTx, 0, len(txs))
+	for _, tx := range txs {
+		decodedTxs = append(decodedTxs, h.txDecoder.Decode(tx))
+	}
+
+	return &pb.GetDecodedBlockByHeightResponse{
+		BlockId:  resp.GetBlockId(),
+		SdkBlock: remapSDKBlock(resp.GetSdkBlock()),
+		Txs:      decodedTxs,
+	}, nil
+}
+
 // GetLatestValidatorSet queries latest validator-set.
 func (h *ServiceHandler) GetLatestValidatorSet(
 	ctx context.Context, req *pb.GetLatestValidatorSetRequest) (*pb.GetLatestValidatorSetResponse, error) {
-	resp, err := h.ServiceGRPCClient.GetLatestValidatorSet(ctx, &tmservice.GetLatestValidatorSetRequest{
+	serviceClient, err := h.serviceClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := serviceClient.GetLatestValidatorSet(ctx, &tmservice.GetLatestValidatorSetRequest{
 		Pagination: req.Pagination,
 	})
 	if err != nil {
-		return nil, err
+		return nil, h.upstreamError(ctx, "GetLatestValidatorSet", err)
 	}
 
 	return &pb.GetLatestValidatorSetResponse{
@@ -110,12 +186,17 @@ func (h *ServiceHandler) GetLatestValidatorSet(
 // GetValidatorSetByHeight queries validator-set at a given height.
 func (h *ServiceHandler) GetValidatorSetByHeight(
 	ctx context.Context, req *pb.GetValidatorSetByHeightRequest) (*pb.GetValidatorSetByHeightResponse, error) {
-	resp, err := h.ServiceGRPCClient.GetValidatorSetByHeight(ctx, &tmservice.GetValidatorSetByHeightRequest{
+	serviceClient, err := h.serviceClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := serviceClient.GetValidatorSetByHeight(ctx, &tmservice.GetValidatorSetByHeightRequest{
 		Height:     req.Height,
 		Pagination: req.Pagination,
 	})
 	if err != nil {
-		return nil, err
+		return nil, h.upstreamError(ctx, "GetValidatorSetByHeight", err)
 	}
 
 	return &pb.GetValidatorSetByHeightResponse{
@@ -129,14 +210,19 @@ func (h *ServiceHandler) GetValidatorSetByHeight(
 // application, bypassing Tendermint completely. The ABCI query must contain
 // a valid and supported path, including app, custom, p2p, and store.
 func (h *ServiceHandler) ABCIQuery(ctx context.Context, req *pb.ABCIQueryRequest) (*pb.ABCIQueryResponse, error) {
-	resp, err := h.ServiceGRPCClient.ABCIQuery(ctx, &tmservice.ABCIQueryRequest{
+	serviceClient, err := h.serviceClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := serviceClient.ABCIQuery(ctx, &tmservice.ABCIQueryRequest{
 		Data:   req.Data,
 		Path:   req.Path,
 		Height: req.Height,
 		Prove:  req.Prove,
 	})
 	if err != nil {
-		return nil, err
+		return nil, h.upstreamError(ctx, "ABCIQuery", err)
 	}
 
 	return &pb.ABCIQueryResponse{
@@ -152,80 +238,39 @@ func (h *ServiceHandler) ABCIQuery(ctx context.Context, req *pb.ABCIQueryRequest
 	}, nil
 }
 
-func remapValidators(resp []*tmservice.Validator) []*pb.Validator {
-	validators := make([]*pb.Validator, 0)
-
-	for _, v := range resp {
-		validators = append(validators, &pb.Validator{
-			Address:          v.GetAddress(),
-			PubKey:           v.GetPubKey(),
-			VotingPower:      v.GetVotingPower(),
-			ProposerPriority: v.GetProposerPriority(),
-		})
+// serviceClient returns a client for the upstream pool of the chain selected by the call.
+// Its calls are eligible for mirroring to a shadow upstream.
+func (h *ServiceHandler) serviceClient(ctx context.Context) (tmservice.ServiceClient, error) {
+	pool, err := h.Router.Route(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return validators
-}
-
-func remapBuildDeps(resp []*tmservice.Module) []*pb.Module {
-	modules := make([]*pb.Module, 0)
-
-	for _, m := range resp {
-		modules = append(modules, &pb.Module{
-			Path:    m.Path,
-			Version: m.Version,
-			Sum:     m.Sum,
-		})
+	conn, err := pool.Conn()
+	if err != nil {
+		return nil, err
 	}
 
-	return modules
+	return tmservice.NewServiceClient(shadow.NewMirroredConn(conn)), nil
 }
 
-func remapProofOps(resp *tmservice.ProofOps) *pb.ProofOps {
-	if resp == nil {
-		return nil
-	}
-
-	proofOps := make([]pb.ProofOp, 0)
-	for _, p := range resp.Ops {
-		proofOps = append(proofOps, pb.ProofOp{
-			Type: p.GetType(),
-			Key:  p.GetKey(),
-			Data: p.GetData(),
-		})
-	}
+// upstreamError logs a failed upstream call with the request ID and passes the error through.
+func (h *ServiceHandler) upstreamError(ctx context.Context, method string, err error) error {
+	requestLogger(ctx, h.logger).Warn("upstream call failed",
+		log.String("chain_id", upstream.ChainIDFromContext(ctx)),
+		log.String("upstream_method", method),
+		log.Error(err),
+	)
 
-	return &pb.ProofOps{
-		Ops: proofOps,
-	}
+	return err
 }
 
-func remapSDKBlock(resp *tmservice.Block) *pb.Block {
-	if resp == nil {
-		return nil
-	}
-
-	header := resp.GetHeader()
-
-	return &pb.Block{
-		Header: pb.Header{
-			Version:            header.GetVersion(),
-			ChainID:            header.GetChainID(),
-			Height:             header.GetHeight(),
-			Time:               header.GetTime(),
-			LastBlockId:        header.GetLastBlockId(),
-			LastCommitHash:     header.GetLastCommitHash(),
-			DataHash:           header.GetDataHash(),
-			ValidatorsHash:     header.GetValidatorsHash(),
-			NextValidatorsHash: header.GetNextValidatorsHash(),
-			ConsensusHash:      header.GetConsensusHash(),
-			AppHash:            header.GetAppHash(),
-			LastResultsHash:    header.GetLastResultsHash(),
-			EvidenceHash:       header.GetEvidenceHash(),
-			ProposerAddress:    header.GetProposerAddress(),
-		},
-		Data:       resp.GetData(),
-		Evidence:   resp.GetEvidence(),
-		LastCommit: resp.GetLastCommit(),
+// requestLogger adds the request ID of the call to logger. Unlike the request logger stored in the
+// context by the interceptors, it keeps the name of logger, so that per-logger levels still apply.
+func requestLogger(ctx context.Context, logger log.Logger) log.Logger {
+	if requestID := logging.RequestIDFromContext(ctx); requestID != "" {
+		return logger.With(log.String("request_id", requestID))
 	}
+
+	return logger
 }
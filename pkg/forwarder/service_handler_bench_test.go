@@ -0,0 +1,172 @@
+package forwarder_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+const (
+	_benchHeight     = 1000
+	_benchValidators = 175
+	_benchTxs        = 2000
+	_benchTxSize     = 400
+	_benchBuildDeps  = 200
+)
+
+// benchConn answers with prebuilt upstream responses, so that benchmarks measure the handlers only.
+// Responses are copied shallowly into the reply like a decoded response would be assigned.
+type benchConn struct {
+	nodeInfo   *tmservice.GetNodeInfoResponse
+	block      *tmservice.GetBlockByHeightResponse
+	validators *tmservice.GetValidatorSetByHeightResponse
+	abciQuery  *tmservice.ABCIQueryResponse
+}
+
+func newBenchConn(b *testing.B) *benchConn {
+	b.Helper()
+
+	ctx := context.Background()
+	fake := testrunner.NewFakeUpstream("bench-1", _benchHeight, _benchValidators)
+
+	nodeInfo, err := fake.GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for i := 0; i < _benchBuildDeps; i++ {
+		nodeInfo.ApplicationVersion.BuildDeps = append(nodeInfo.ApplicationVersion.BuildDeps, &tmservice.Module{
+			Path:    fmt.Sprintf("github.com/example/module%d", i),
+			Version: "v1.0.0",
+			Sum:     "h1:47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+		})
+	}
+
+	validators, err := fake.GetValidatorSetByHeight(ctx, &tmservice.GetValidatorSetByHeightRequest{
+		Height:     _benchHeight,
+		Pagination: &query.PageRequest{Limit: _benchValidators},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	ops := make([]tmservice.ProofOp, 0, 3)
+	for _, opType := range []string{"ics23:iavl", "ics23:simple", "ics23:simple"} {
+		ops = append(ops, tmservice.ProofOp{Type: opType, Key: make([]byte, 32), Data: make([]byte, 1024)})
+	}
+
+	return &benchConn{
+		nodeInfo:   nodeInfo,
+		block:      fake.LargeBlock(_benchHeight, _benchTxs, _benchTxSize),
+		validators: validators,
+		abciQuery: &tmservice.ABCIQueryResponse{
+			Key:      make([]byte, 32),
+			Value:    make([]byte, 256),
+			ProofOps: &tmservice.ProofOps{Ops: ops},
+			Height:   _benchHeight,
+		},
+	}
+}
+
+func (c *benchConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	switch reply := reply.(type) {
+	case *tmservice.GetNodeInfoResponse:
+		*reply = *c.nodeInfo
+	case *tmservice.GetBlockByHeightResponse:
+		*reply = *c.block
+	case *tmservice.GetValidatorSetByHeightResponse:
+		*reply = *c.validators
+	case *tmservice.ABCIQueryResponse:
+		*reply = *c.abciQuery
+	default:
+		return status.Errorf(codes.Unimplemented, "unexpected method %s", method)
+	}
+
+	return nil
+}
+
+func (c *benchConn) NewStream(
+	ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "unexpected method %s", method)
+}
+
+func newBenchServiceHandler(b *testing.B) *forwarder.ServiceHandler {
+	b.Helper()
+
+	return forwarder.NewServiceHandler(
+		newTestRouter(newBenchConn(b)),
+		forwarder.NewTxDecoder(registry.NewInterfaceRegistry()),
+		forwarder.BlockRangeLimits{MaxConcurrency: 4},
+		nil,
+		log.InitializeLogger("error", "json"),
+	)
+}
+
+func BenchmarkGetNodeInfo(b *testing.B) {
+	ctx := context.Background()
+	handler := newBenchServiceHandler(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := handler.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetBlockByHeight(b *testing.B) {
+	ctx := context.Background()
+	handler := newBenchServiceHandler(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := handler.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: _benchHeight}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetValidatorSetByHeight(b *testing.B) {
+	ctx := context.Background()
+	handler := newBenchServiceHandler(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := handler.GetValidatorSetByHeight(ctx, &pb.GetValidatorSetByHeightRequest{Height: _benchHeight})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkABCIQuery(b *testing.B) {
+	ctx := context.Background()
+	handler := newBenchServiceHandler(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := handler.ABCIQuery(ctx, &pb.ABCIQueryRequest{Path: "/store/bank/key", Prove: true}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
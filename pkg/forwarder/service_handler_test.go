@@ -1,22 +1,27 @@
 package forwarder_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/google/go-cmp/cmp"
-	"github.com/joho/godotenv"
 	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
-	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type testClients struct {
@@ -24,34 +29,84 @@ type testClients struct {
 	originClient    tmservice.ServiceClient
 }
 
-func TestServiceHandlerForwardedCalls(t *testing.T) {
-	var err error
-
+func TestServiceHandlerForwardedCallsFakeUpstream(t *testing.T) {
 	ctx := context.Background()
 
-	err = godotenv.Load("../../.env.test.dist")
-	if err != nil {
-		t.Fatal(err)
-	}
+	logger := log.InitializeLogger("error", "json")
 
-	appConfig := configs.InitializeConfig()
+	jsonConverter := jsonconv.NewJSONConverter()
 
-	logger := log.InitializeLogger(appConfig.LogLevel, appConfig.LogFormat)
+	fake := testrunner.NewFakeUpstream("osmosis-1", 8658300, 150)
 
-	jsonConverter := jsonconv.NewJSONConverter()
+	testConfig := testrunner.NewDefaultTestConfig(logger, &configs.Config{}, jsonConverter)
+	testConfig.Upstreams = []*testrunner.FakeUpstream{fake}
 
-	grpcClients, closer := setupTest(ctx, t, appConfig, logger, jsonConverter)
+	conn, closer, err := testrunner.NewUnaryTestSetup(ctx, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
 	defer closer()
 
+	originConn, closeOrigin, err := fake.Dial(ctx, testConfig.InterfaceRegistry, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeOrigin()
+
+	grpcClients := testClients{
+		forwarderClient: pb.NewServiceClient(conn),
+		originClient:    tmservice.NewServiceClient(originConn),
+	}
+
 	verifyGetLatestBlock(ctx, t, grpcClients, jsonConverter)
 	verifyGetBlockByHeight(ctx, t, grpcClients, jsonConverter)
 	verifyGetSyncing(ctx, t, grpcClients, jsonConverter)
 	verifyGetNodeInfo(ctx, t, grpcClients, jsonConverter)
-	//verifyGetLatestValidatorSet(ctx, t, grpcClients, jsonConverter)
-	//verifyABCIQuery(ctx, t, grpcClients, jsonConverter)
+	verifyGetLatestValidatorSet(ctx, t, grpcClients, jsonConverter)
+	verifyABCIQuery(ctx, t, grpcClients, jsonConverter)
 	verifyGetValidatorSetByHeight(ctx, t, grpcClients, jsonConverter)
 }
 
+func TestServiceHandlerUpstreamErrorKeepsLoggerName(t *testing.T) {
+	var buf bytes.Buffer
+
+	ctx := logging.NewRequestIDContext(context.Background(), "req-123")
+	logger := log.New(log.WithLogToStdout(false), log.WithOutput(&buf))
+
+	fake := testrunner.NewFakeUpstream("test-1", 10, 1)
+	fake.FailWith("GetNodeInfo", status.Error(codes.Unavailable, "down"))
+
+	conn, closer, err := fake.Dial(ctx, registry.NewInterfaceRegistry(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer()
+
+	handler := forwarder.NewServiceHandler(newTestRouter(conn), forwarder.NewTxDecoder(registry.NewInterfaceRegistry()),
+		forwarder.BlockRangeLimits{}, nil, logger.Named("forwarder"))
+
+	if _, err := handler.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+
+	for _, want := range []string{`"logger":"forwarder"`, `"request_id":"req-123"`, `"msg":"upstream call failed"`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected log to contain %s, got %s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	logger.SetLevel("forwarder", log.ErrorLevel)
+
+	if _, err := handler.GetNodeInfo(ctx, &pb.GetNodeInfoRequest{}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("expected the forwarder log level to silence the warning, got %s", buf.String())
+	}
+}
+
 func verifyGetLatestBlock(
 	ctx context.Context,
 	t *testing.T,
@@ -157,7 +212,6 @@ func verifyGetValidatorSetByHeight(
 	verifyResponses(t, resp, originResp, jsonConverter)
 }
 
-//nolint:unused
 func verifyGetLatestValidatorSet(
 	ctx context.Context,
 	t *testing.T,
@@ -181,7 +235,6 @@ func verifyGetLatestValidatorSet(
 	verifyResponses(t, resp, originResp, jsonConverter)
 }
 
-//nolint:unused
 func verifyABCIQuery(
 	ctx context.Context,
 	t *testing.T,
@@ -211,39 +264,6 @@ func verifyABCIQuery(
 	verifyResponses(t, resp, originResp, jsonConverter)
 }
 
-func setupTest(
-	ctx context.Context,
-	t *testing.T,
-	appConfig *configs.Config,
-	logger log.Logger,
-	jsonConverter *jsonconv.JSONConverter,
-) (testClients, func()) {
-	testConfig := testrunner.NewDefaultTestConfig(logger, appConfig, jsonConverter)
-
-	conn, closer, err := testrunner.NewUnaryTestSetup(ctx, testConfig)
-	if err != nil {
-		t.Error(err)
-	}
-
-	cosmosConn, err := client.NewDefaultGRPCConn(
-		ctx,
-		logger,
-		jsonConverter,
-		appConfig.CosmosSDKGRPCEndpoint,
-	)
-	if err != nil {
-		t.Error(err)
-	}
-
-	forwarderClient := pb.NewServiceClient(conn)
-	cosmosClient := tmservice.NewServiceClient(cosmosConn)
-
-	return testClients{
-		forwarderClient: forwarderClient,
-		originClient:    cosmosClient,
-	}, closer
-}
-
 func verifyResponses(
 	t *testing.T, resp proto.Message,
 	originResp proto.Message,
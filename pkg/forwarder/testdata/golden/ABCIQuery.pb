@@ -0,0 +1,8 @@
+`exists2key:valueBC
+
+
+ics23:iavlkey
+iavl-proof
+"
+ics23:simplebanksimple-proofH
+Rsdk
\ No newline at end of file
@@ -0,0 +1,2 @@
+exists2key:valueH
+Rsdk
\ No newline at end of file
@@ -0,0 +1,71 @@
+
+
+H
+ 
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+$ 
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+	osmosis-1
+"Ӿ*H
+ 																																$ 																																2 : B J R Z b j r4cosmosvalcons142424242424242424242424242424242veuamw@1479DEE453BE5F83E6064A002B4ED5BD8524F56FB1C0813F526D71887F978917@65DB53310BEB43AB8E8A5B7E6CB6540B85F308471D88F3BBE2740C79140ACECF
\ No newline at end of file
@@ -0,0 +1,7 @@
+
+
+(5f2f6f3e0e4c1d7a9b8c7d6e5f4a3b2c1d0e9f8atcp://0.0.0.0:26656"	osmosis-1*0.37.12@ !:conformanceB
+offtcp://0.0.0.0:26657
+osmosisosmosisd15.1.0"(6cb6f0c1b1c6e4d2b6a8c6b5b1e1d5f3a9a3c1e2*netgo,ledger2go version go1.20.3 linux/amd64:0
+github.com/cosmos/cosmos-sdkv0.47.2h1:sdk=:0
+github.com/cometbft/cometbftv0.37.1h1:cmt=Bv0.47.2
\ No newline at end of file
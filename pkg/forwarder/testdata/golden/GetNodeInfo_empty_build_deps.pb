@@ -0,0 +1,4 @@
+
+
+(5f2f6f3e0e4c1d7a9b8c7d6e5f4a3b2c1d0e9f8atcp://0.0.0.0:26656"	osmosis-1*0.37.12@ !:conformanceB
+offtcp://0.0.0.0:26657osmosisdBv0.47.2
\ No newline at end of file
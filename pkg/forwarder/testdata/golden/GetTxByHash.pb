@@ -0,0 +1,67 @@
+
+@65DB53310BEB43AB8E8A5B7E6CB6540B85F308471D88F3BBE2740C79140ACECF
+"H
+ 
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+$ 
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
+
@@ -0,0 +1,13 @@
+
+
+4cosmosvalcons182mz7rvnsjd7f90zrclfqya9zupc73daekau9uC
+/cosmos.crypto.ed25519.PubKey"
+ sE
+t-2cI1{!2 
+"~
+4cosmosvalcons1v0thzgvzp8vt6q7ystmfm7a9wvg0ppsfdc0lavC
+/cosmos.crypto.ed25519.PubKey"
+ N 㓖ZHcwwqlt*J
+4cosmosvalcons1tmemtuju2j2x6j5flsxsn5h3yes52s8j5r49m8d 2(
+8*]
+4cosmosvalcons1u009krnj9e6xgwrkgjgud0k3j2y5ktlpra6axz(08
\ No newline at end of file
@@ -0,0 +1,97 @@
+package forwarder
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/gogoproto/proto"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+)
+
+// TxDecoder decodes raw block transactions into their messages, fee, memo and signer info.
+type TxDecoder struct {
+	decode sdk.TxDecoder
+}
+
+// NewTxDecoder is a constructor function for TxDecoder. Transactions are decoded with the
+// Cosmos SDK tx decoder, which resolves messages and public keys through interfaceRegistry.
+func NewTxDecoder(interfaceRegistry codectypes.InterfaceRegistry) *TxDecoder {
+	if interfaceRegistry == nil {
+		interfaceRegistry = codectypes.NewInterfaceRegistry()
+	}
+
+	return &TxDecoder{
+		decode: authtx.DefaultTxDecoder(codec.NewProtoCodec(interfaceRegistry)),
+	}
+}
+
+// Decode decodes raw transaction bytes. A transaction which cannot be decoded is returned
+// with its hash and the decoding error instead of failing the whole block. If only its messages
+// or public keys can't be resolved, e.g. those of chain-specific modules, its fields are kept
+// with raw Any values next to the error.
+func (d *TxDecoder) Decode(txBytes []byte) *pb.DecodedTx {
+	decoded := &pb.DecodedTx{
+		Hash: indexer.TxHash(txBytes),
+	}
+
+	tx, err := d.decodeTx(txBytes)
+	if err != nil {
+		decoded.DecodeError = err.Error()
+
+		if tx, err = decodeRawTx(txBytes); err != nil {
+			return decoded
+		}
+	}
+
+	decoded.Messages = tx.GetBody().GetMessages()
+	decoded.Memo = tx.GetBody().GetMemo()
+	decoded.TimeoutHeight = tx.GetBody().GetTimeoutHeight()
+	decoded.Fee = tx.GetAuthInfo().GetFee()
+	decoded.SignerInfos = tx.GetAuthInfo().GetSignerInfos()
+	decoded.Signatures = tx.GetSignatures()
+
+	return decoded
+}
+
+func (d *TxDecoder) decodeTx(txBytes []byte) (*txtypes.Tx, error) {
+	sdkTx, err := d.decode(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTx, ok := sdkTx.(interface{ GetProtoTx() *txtypes.Tx })
+	if !ok {
+		return nil, fmt.Errorf("unexpected decoded tx type %T", sdkTx)
+	}
+
+	return protoTx.GetProtoTx(), nil
+}
+
+// decodeRawTx decodes the transaction structure without resolving any Any values.
+func decodeRawTx(txBytes []byte) (*txtypes.Tx, error) {
+	var (
+		raw      txtypes.TxRaw
+		body     txtypes.TxBody
+		authInfo txtypes.AuthInfo
+	)
+
+	if err := proto.Unmarshal(txBytes, &raw); err != nil {
+		return nil, err
+	}
+
+	if err := proto.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, err
+	}
+
+	if err := proto.Unmarshal(raw.AuthInfoBytes, &authInfo); err != nil {
+		return nil, err
+	}
+
+	return &txtypes.Tx{Body: &body, AuthInfo: &authInfo, Signatures: raw.Signatures}, nil
+}
@@ -0,0 +1,138 @@
+package forwarder_test
+
+import (
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+func TestTxDecoderDecode(t *testing.T) {
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txBytes := encodeTestTx(t, msg)
+
+	decoder := forwarder.NewTxDecoder(registry.NewInterfaceRegistry())
+	decoded := decoder.Decode(txBytes)
+
+	if decoded.DecodeError != "" {
+		t.Fatalf("unexpected decode error: %s", decoded.DecodeError)
+	}
+
+	if decoded.Hash != indexer.TxHash(txBytes) || len(decoded.Hash) != 64 {
+		t.Errorf("unexpected hash %q", decoded.Hash)
+	}
+
+	if decoded.Memo != "memo" || decoded.TimeoutHeight != 42 {
+		t.Errorf("unexpected memo %q or timeout height %d", decoded.Memo, decoded.TimeoutHeight)
+	}
+
+	if decoded.Fee.GetGasLimit() != 200000 {
+		t.Errorf("unexpected gas limit %d", decoded.Fee.GetGasLimit())
+	}
+
+	if len(decoded.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(decoded.Messages))
+	}
+
+	if _, ok := decoded.Messages[0].GetCachedValue().(*banktypes.MsgSend); !ok {
+		t.Errorf("expected registered message to be unpacked, got %T", decoded.Messages[0].GetCachedValue())
+	}
+
+	if len(decoded.Signatures) != 1 {
+		t.Errorf("expected 1 signature, got %d", len(decoded.Signatures))
+	}
+}
+
+func TestTxDecoderDecodeUnknownMessage(t *testing.T) {
+	txBytes := encodeTestTx(t, &codectypes.Any{TypeUrl: "/chain.custom.v1.MsgUnknown", Value: []byte{0x0a, 0x01, 0x61}})
+
+	decoded := forwarder.NewTxDecoder(registry.NewInterfaceRegistry()).Decode(txBytes)
+
+	if !strings.Contains(decoded.DecodeError, "/chain.custom.v1.MsgUnknown") {
+		t.Errorf("expected a decode error for the unknown message, got %q", decoded.DecodeError)
+	}
+
+	if len(decoded.Messages) != 1 || decoded.Messages[0].TypeUrl != "/chain.custom.v1.MsgUnknown" {
+		t.Errorf("expected unknown message to be kept raw, got %v", decoded.Messages)
+	}
+
+	if decoded.Memo != "memo" {
+		t.Errorf("expected the memo to be kept, got %q", decoded.Memo)
+	}
+}
+
+func TestTxDecoderDecodeMalformedPubKey(t *testing.T) {
+	txBytes := encodeTestTx(t, nil, &txtypes.SignerInfo{
+		PublicKey: &codectypes.Any{TypeUrl: "/cosmos.crypto.secp256k1.PubKey", Value: []byte{0xff, 0x01}},
+	})
+
+	decoded := forwarder.NewTxDecoder(registry.NewInterfaceRegistry()).Decode(txBytes)
+
+	if decoded.DecodeError == "" {
+		t.Error("expected a decode error for the malformed public key")
+	}
+}
+
+// encodeTestTx encodes a transaction with a memo, a timeout height, a fee and one signature.
+func encodeTestTx(t *testing.T, msg *codectypes.Any, signerInfos ...*txtypes.SignerInfo) []byte {
+	t.Helper()
+
+	body := &txtypes.TxBody{Memo: "memo", TimeoutHeight: 42}
+	if msg != nil {
+		body.Messages = []*codectypes.Any{msg}
+	}
+
+	bodyBytes, err := proto.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authInfoBytes, err := proto.Marshal(&txtypes.AuthInfo{
+		SignerInfos: signerInfos,
+		Fee:         &txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), GasLimit: 200000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txBytes, err := proto.Marshal(&txtypes.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{[]byte("signature")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return txBytes
+}
+
+func TestTxDecoderDecodeInvalid(t *testing.T) {
+	txBytes := []byte{0xff, 0xff, 0xff}
+
+	decoded := forwarder.NewTxDecoder(registry.NewInterfaceRegistry()).Decode(txBytes)
+
+	if decoded.DecodeError == "" {
+		t.Error("expected decode error")
+	}
+
+	if decoded.Hash != indexer.TxHash(txBytes) {
+		t.Errorf("expected hash to be set, got %q", decoded.Hash)
+	}
+}
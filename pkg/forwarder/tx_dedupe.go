@@ -0,0 +1,84 @@
+package forwarder
+
+import (
+	"sync"
+	"time"
+)
+
+// BroadcastDedupe detects repeated broadcasts of the same transaction within a time window.
+// Transactions are keyed by their hash, so a resubmission of identical tx bytes is caught
+// even when it comes from a different client.
+type BroadcastDedupe struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+	// order lists broadcasts by submission time, so expired ones are evicted from its front
+	// without scanning seen.
+	order []dedupeEntry
+}
+
+type dedupeEntry struct {
+	hash        string
+	submittedAt time.Time
+}
+
+// NewBroadcastDedupe is a constructor function for BroadcastDedupe.
+// A zero or negative window disables deduplication.
+func NewBroadcastDedupe(window time.Duration) *BroadcastDedupe {
+	return &BroadcastDedupe{
+		window: window,
+		now:    time.Now,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// Acquire records a broadcast of the transaction with the given hash. It returns false together
+// with the time of the first submission if the same transaction was already broadcast within the window.
+func (d *BroadcastDedupe) Acquire(hash string) (time.Time, bool) {
+	if d.window <= 0 {
+		return time.Time{}, true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+
+	for len(d.order) > 0 && now.Sub(d.order[0].submittedAt) >= d.window {
+		oldest := d.order[0]
+		d.order = d.order[1:]
+
+		// A released and broadcast again transaction has a newer entry further back.
+		if submittedAt, ok := d.seen[oldest.hash]; ok && submittedAt.Equal(oldest.submittedAt) {
+			delete(d.seen, oldest.hash)
+		}
+	}
+
+	if submittedAt, ok := d.seen[hash]; ok {
+		return submittedAt, false
+	}
+
+	d.seen[hash] = now
+	d.order = append(d.order, dedupeEntry{hash: hash, submittedAt: now})
+
+	return now, true
+}
+
+// Len returns the number of transactions currently tracked within the window.
+func (d *BroadcastDedupe) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.seen)
+}
+
+// Release forgets a transaction so that it can be broadcast again, e.g. after it was rejected upstream.
+// Its entry in the eviction order is skipped once it expires.
+func (d *BroadcastDedupe) Release(hash string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.seen, hash)
+}
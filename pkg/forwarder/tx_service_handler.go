@@ -0,0 +1,223 @@
+package forwarder
+
+import (
+	"context"
+	"time"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// TxServiceHandler implements cosmos.tx.v1beta1.Service gRPC service by forwarding
+// transaction broadcasts, simulations and queries to the Cosmos SDK endpoint.
+type TxServiceHandler struct {
+	Router      *upstream.Router
+	dedupe      *BroadcastDedupe
+	logger      log.Logger
+	auditLogger log.Logger
+	*txtypes.UnimplementedServiceServer
+}
+
+// NewTxServiceHandler is a constructor function for TxServiceHandler.
+func NewTxServiceHandler(
+	router *upstream.Router,
+	dedupe *BroadcastDedupe,
+	logger log.Logger,
+	auditLogger log.Logger,
+) *TxServiceHandler {
+	return &TxServiceHandler{
+		Router:                     router,
+		dedupe:                     dedupe,
+		logger:                     logger,
+		auditLogger:                auditLogger,
+		UnimplementedServiceServer: &txtypes.UnimplementedServiceServer{},
+	}
+}
+
+// BroadcastTx broadcasts a transaction. Broadcasts are sent exactly once to the primary upstream
+// of the selected chain: they are never retried or hedged, since a transaction that reached
+// the mempool must not be submitted twice. A transaction already broadcast within the dedupe window
+// is rejected with AlreadyExists. Every broadcast attempt is written to the audit log.
+func (h *TxServiceHandler) BroadcastTx(
+	ctx context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	pool, err := h.Router.Route(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pool.Primary()
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	hash := indexer.TxHash(req.GetTxBytes())
+
+	firstSubmittedAt, ok := h.dedupe.Acquire(hash)
+	if !ok {
+		err = status.Errorf(codes.AlreadyExists,
+			"transaction %s was already broadcast at %s", hash, firstSubmittedAt.UTC().Format(time.RFC3339))
+		h.audit(ctx, req, hash, nil, err, true, time.Since(start))
+
+		return nil, err
+	}
+
+	resp, err := txtypes.NewServiceClient(conn).BroadcastTx(ctx, req)
+
+	// A transaction which was rejected before reaching the mempool can be fixed and resubmitted by
+	// the client. Any other failure may have happened after the upstream accepted it, so it stays held.
+	if rejectedBeforeMempool(resp, err) {
+		h.dedupe.Release(hash)
+	}
+
+	h.audit(ctx, req, hash, resp, err, false, time.Since(start))
+
+	if err != nil {
+		return nil, h.upstreamError(ctx, "BroadcastTx", err)
+	}
+
+	return resp, nil
+}
+
+// Simulate simulates executing a transaction for estimating gas usage.
+func (h *TxServiceHandler) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	txClient, err := h.txClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := txClient.Simulate(ctx, req)
+	if err != nil {
+		return nil, h.upstreamError(ctx, "Simulate", err)
+	}
+
+	return resp, nil
+}
+
+// GetTx fetches a tx by hash.
+func (h *TxServiceHandler) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	txClient, err := h.txClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := txClient.GetTx(ctx, req)
+	if err != nil {
+		return nil, h.upstreamError(ctx, "GetTx", err)
+	}
+
+	return resp, nil
+}
+
+// GetTxsEvent fetches txs by event.
+func (h *TxServiceHandler) GetTxsEvent(
+	ctx context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	txClient, err := h.txClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := txClient.GetTxsEvent(ctx, req)
+	if err != nil {
+		return nil, h.upstreamError(ctx, "GetTxsEvent", err)
+	}
+
+	return resp, nil
+}
+
+// txClient returns a client for the upstream pool of the chain selected by the call.
+func (h *TxServiceHandler) txClient(ctx context.Context) (txtypes.ServiceClient, error) {
+	pool, err := h.Router.Route(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pool.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return txtypes.NewServiceClient(conn), nil
+}
+
+// audit writes one audit log entry for a broadcast attempt.
+func (h *TxServiceHandler) audit(
+	ctx context.Context,
+	req *txtypes.BroadcastTxRequest,
+	hash string,
+	resp *txtypes.BroadcastTxResponse,
+	err error,
+	duplicate bool,
+	duration time.Duration,
+) {
+	var peerAddr string
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerAddr = p.Addr.String()
+	}
+
+	fields := []log.Field{
+		log.String("request_id", logging.RequestIDFromContext(ctx)),
+		log.String("chain_id", upstream.ChainIDFromContext(ctx)),
+		log.String("peer", peerAddr),
+		log.String("tx_hash", hash),
+		log.String("mode", req.GetMode().String()),
+		log.Int("tx_bytes", len(req.GetTxBytes())),
+		log.Bool("duplicate", duplicate),
+		log.String("grpc_code", status.Code(err).String()),
+		log.Error(err),
+		log.Float64("duration_ms", float64(duration)/float64(time.Millisecond)),
+	}
+
+	if txResp := resp.GetTxResponse(); txResp != nil {
+		fields = append(fields,
+			log.Uint32("code", txResp.Code),
+			log.String("codespace", txResp.Codespace),
+			log.Int64("height", txResp.Height),
+		)
+	}
+
+	if err != nil || duplicate || txResponseCode(resp) != 0 {
+		h.auditLogger.Warn("broadcast", fields...)
+	} else {
+		h.auditLogger.Info("broadcast", fields...)
+	}
+}
+
+// upstreamError logs a failed upstream call with the request ID and passes the error through.
+func (h *TxServiceHandler) upstreamError(ctx context.Context, method string, err error) error {
+	requestLogger(ctx, h.logger).Warn("upstream call failed",
+		log.String("chain_id", upstream.ChainIDFromContext(ctx)),
+		log.String("upstream_method", method),
+		log.Error(err),
+	)
+
+	return err
+}
+
+// rejectedBeforeMempool reports whether a broadcast was turned down by CheckTx or refused upstream
+// as invalid, so that the transaction certainly didn't enter the mempool.
+func rejectedBeforeMempool(resp *txtypes.BroadcastTxResponse, err error) bool {
+	if err != nil {
+		code := status.Code(err)
+
+		return code == codes.InvalidArgument || code == codes.FailedPrecondition
+	}
+
+	return txResponseCode(resp) != 0
+}
+
+func txResponseCode(resp *txtypes.BroadcastTxResponse) uint32 {
+	if txResp := resp.GetTxResponse(); txResp != nil {
+		return txResp.Code
+	}
+
+	return 0
+}
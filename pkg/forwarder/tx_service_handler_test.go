@@ -0,0 +1,163 @@
+package forwarder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+func newTestRouter(conn grpc.ClientConnInterface) *upstream.Router {
+	return upstream.NewRouter("", upstream.NewPool("test-1", &upstream.Upstream{Endpoint: "fake", Conn: conn}))
+}
+
+func TestTxServiceHandlerBroadcastTxDedupe(t *testing.T) {
+	ctx := context.Background()
+	logger := log.InitializeLogger("error", "json")
+	fake := testrunner.NewFakeUpstream("test-1", 10, 1)
+
+	handler := forwarder.NewTxServiceHandler(newTestRouter(fake.Conn(t)), forwarder.NewBroadcastDedupe(time.Minute), logger, logger)
+	req := &txtypes.BroadcastTxRequest{TxBytes: []byte("tx"), Mode: txtypes.BroadcastMode_BROADCAST_MODE_SYNC}
+
+	if _, err := handler.BroadcastTx(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := handler.BroadcastTx(ctx, req)
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists for a duplicate broadcast, got %v", err)
+	}
+
+	if fake.Calls("BroadcastTx") != 1 {
+		t.Errorf("expected 1 upstream broadcast, got %d", fake.Calls("BroadcastTx"))
+	}
+
+	if _, err := handler.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{TxBytes: []byte("other")}); err != nil {
+		t.Errorf("unexpected error for a different transaction: %v", err)
+	}
+}
+
+func TestTxServiceHandlerBroadcastTxRejectedIsReleased(t *testing.T) {
+	ctx := context.Background()
+	logger := log.InitializeLogger("error", "json")
+	fake := testrunner.NewFakeUpstream("test-1", 10, 1)
+	fake.SetCheckTxCode(5)
+
+	handler := forwarder.NewTxServiceHandler(newTestRouter(fake.Conn(t)), forwarder.NewBroadcastDedupe(time.Minute), logger, logger)
+	req := &txtypes.BroadcastTxRequest{TxBytes: []byte("tx")}
+
+	for i := 0; i < 2; i++ {
+		if _, err := handler.BroadcastTx(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if fake.Calls("BroadcastTx") != 2 {
+		t.Errorf("expected a rejected transaction to be resubmittable, got %d upstream broadcasts", fake.Calls("BroadcastTx"))
+	}
+}
+
+func TestTxServiceHandlerBroadcastTxUpstreamFailure(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		released bool
+	}{
+		{"unavailable", status.Error(codes.Unavailable, "connection reset"), false},
+		{"deadline exceeded", status.Error(codes.DeadlineExceeded, "timeout"), false},
+		{"invalid argument", status.Error(codes.InvalidArgument, "tx parse error"), true},
+		{"failed precondition", status.Error(codes.FailedPrecondition, "insufficient fee"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := log.InitializeLogger("error", "json")
+			fake := testrunner.NewFakeUpstream("test-1", 10, 1)
+			fake.FailWith("BroadcastTx", tt.err)
+
+			handler := forwarder.NewTxServiceHandler(newTestRouter(fake.Conn(t)), forwarder.NewBroadcastDedupe(time.Minute), logger, logger)
+			req := &txtypes.BroadcastTxRequest{TxBytes: []byte("tx")}
+
+			if _, err := handler.BroadcastTx(ctx, req); status.Code(err) != status.Code(tt.err) {
+				t.Fatalf("expected %v, got %v", status.Code(tt.err), err)
+			}
+
+			_, err := handler.BroadcastTx(ctx, req)
+
+			switch {
+			case tt.released && status.Code(err) != status.Code(tt.err):
+				t.Errorf("expected the transaction to be released for resubmission, got %v", err)
+			case !tt.released && status.Code(err) != codes.AlreadyExists:
+				t.Errorf("expected the transaction to stay held, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBroadcastDedupe(t *testing.T) {
+	dedupe := forwarder.NewBroadcastDedupe(50 * time.Millisecond)
+
+	if _, ok := dedupe.Acquire("A"); !ok {
+		t.Fatal("expected first broadcast to be acquired")
+	}
+
+	if _, ok := dedupe.Acquire("A"); ok {
+		t.Fatal("expected duplicate broadcast to be detected")
+	}
+
+	dedupe.Release("A")
+
+	if _, ok := dedupe.Acquire("A"); !ok {
+		t.Fatal("expected released broadcast to be acquired again")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if _, ok := dedupe.Acquire("A"); !ok {
+		t.Fatal("expected broadcast to be acquired after the window expired")
+	}
+
+	disabled := forwarder.NewBroadcastDedupe(0)
+	disabled.Acquire("A")
+
+	if _, ok := disabled.Acquire("A"); !ok {
+		t.Fatal("expected disabled dedupe to never report duplicates")
+	}
+}
+
+func TestBroadcastDedupeEviction(t *testing.T) {
+	dedupe := forwarder.NewBroadcastDedupe(200 * time.Millisecond)
+
+	dedupe.Acquire("A")
+	dedupe.Acquire("B")
+	dedupe.Release("A")
+
+	time.Sleep(120 * time.Millisecond)
+
+	// A is broadcast again after its release, so its first entry must not evict it.
+	dedupe.Acquire("A")
+
+	time.Sleep(120 * time.Millisecond)
+
+	if _, ok := dedupe.Acquire("C"); !ok {
+		t.Fatal("expected a new broadcast to be acquired")
+	}
+
+	if got := dedupe.Len(); got != 2 {
+		t.Errorf("expected B to be evicted and A and C to be tracked, got %d", got)
+	}
+
+	if _, ok := dedupe.Acquire("A"); ok {
+		t.Error("expected the second broadcast of A to still be within the window")
+	}
+}
@@ -0,0 +1,237 @@
+package forwarder
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+const (
+	// _defaultValidatorPageSize matches the maximum page size CometBFT serves for validator-sets.
+	_defaultValidatorPageSize = 100
+	// _maxValidatorSetSize bounds a validator-set walk. Real validator-sets are far smaller,
+	// so a larger set means the upstream paginates inconsistently.
+	_maxValidatorSetSize = 10000
+)
+
+// GetFullValidatorSet queries the complete validator-set at a given height.
+func (h *ServiceHandler) GetFullValidatorSet(
+	ctx context.Context, req *pb.GetFullValidatorSetRequest) (*pb.GetFullValidatorSetResponse, error) {
+	resp := &pb.GetFullValidatorSetResponse{
+		Validators: make([]*pb.Validator, 0),
+	}
+
+	err := h.walkValidatorSet(ctx, req.Height, req.PageSize, func(blockHeight int64, validators []*pb.Validator) error {
+		resp.BlockHeight = blockHeight
+		resp.Validators = append(resp.Validators, validators...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// StreamValidatorSet streams the complete validator-set at a given height one page at a time.
+func (h *ServiceHandler) StreamValidatorSet(
+	req *pb.GetFullValidatorSetRequest, stream pb.Service_StreamValidatorSetServer) error {
+	return h.walkValidatorSet(stream.Context(), req.Height, req.PageSize,
+		func(blockHeight int64, validators []*pb.Validator) error {
+			return stream.Send(&pb.StreamValidatorSetResponse{
+				BlockHeight: blockHeight,
+				Validators:  validators,
+			})
+		},
+	)
+}
+
+// GetValidatorSetDiff compares the complete validator-sets at two heights.
+func (h *ServiceHandler) GetValidatorSetDiff(
+	ctx context.Context, req *pb.GetValidatorSetDiffRequest) (*pb.GetValidatorSetDiffResponse, error) {
+	if req.FromHeight <= 0 || req.ToHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid heights %d and %d: from_height must be positive and to_height must not be negative",
+			req.FromHeight, req.ToHeight)
+	}
+
+	from, err := h.GetFullValidatorSet(ctx, &pb.GetFullValidatorSetRequest{Height: req.FromHeight})
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := h.GetFullValidatorSet(ctx, &pb.GetFullValidatorSetRequest{Height: req.ToHeight})
+	if err != nil {
+		return nil, err
+	}
+
+	added, removed, changed := diffValidatorSets(from.Validators, to.Validators)
+
+	return &pb.GetValidatorSetDiffResponse{
+		FromHeight: from.BlockHeight,
+		ToHeight:   to.BlockHeight,
+		Added:      added,
+		Removed:    removed,
+		Changed:    changed,
+	}, nil
+}
+
+// diffValidatorSets compares two validator-sets by address. All results are sorted by address.
+func diffValidatorSets(
+	from []*pb.Validator,
+	to []*pb.Validator,
+) ([]*pb.Validator, []*pb.Validator, []*pb.ValidatorChange) {
+	fromByAddress := make(map[string]*pb.Validator, len(from))
+	for _, v := range from {
+		fromByAddress[v.Address] = v
+	}
+
+	toByAddress := make(map[string]*pb.Validator, len(to))
+	for _, v := range to {
+		toByAddress[v.Address] = v
+	}
+
+	added := make([]*pb.Validator, 0)
+	removed := make([]*pb.Validator, 0)
+	changed := make([]*pb.ValidatorChange, 0)
+
+	for _, v := range to {
+		before, ok := fromByAddress[v.Address]
+		if !ok {
+			added = append(added, v)
+
+			continue
+		}
+
+		if before.VotingPower == v.VotingPower && before.ProposerPriority == v.ProposerPriority {
+			continue
+		}
+
+		changed = append(changed, &pb.ValidatorChange{
+			Address:               v.Address,
+			FromVotingPower:       before.VotingPower,
+			ToVotingPower:         v.VotingPower,
+			VotingPowerDelta:      v.VotingPower - before.VotingPower,
+			FromProposerPriority:  before.ProposerPriority,
+			ToProposerPriority:    v.ProposerPriority,
+			ProposerPriorityDelta: v.ProposerPriority - before.ProposerPriority,
+		})
+	}
+
+	for _, v := range from {
+		if _, ok := toByAddress[v.Address]; !ok {
+			removed = append(removed, v)
+		}
+	}
+
+	sort.Slice(added, func(i, j int) bool { return added[i].Address < added[j].Address })
+	sort.Slice(removed, func(i, j int) bool { return removed[i].Address < removed[j].Address })
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Address < changed[j].Address })
+
+	return added, removed, changed
+}
+
+// walkValidatorSet fetches the validator-set page by page and passes every page to fn.
+// All pages are fetched from the same upstream. For the latest validator-set, the height
+// returned with the first page is used for all following pages, so that a new block
+// can't change the set in the middle of the walk.
+func (h *ServiceHandler) walkValidatorSet(
+	ctx context.Context,
+	height int64,
+	pageSize uint64,
+	fn func(blockHeight int64, validators []*pb.Validator) error,
+) error {
+	serviceClient, err := h.serviceClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if pageSize == 0 {
+		pageSize = _defaultValidatorPageSize
+	}
+
+	var (
+		blockHeight = height
+		pagination  = &query.PageRequest{Limit: pageSize, CountTotal: true}
+		fetched     uint64
+	)
+
+	for pagination != nil {
+		var (
+			validators []*tmservice.Validator
+			pageResp   *query.PageResponse
+		)
+
+		if blockHeight == 0 {
+			resp, err := serviceClient.GetLatestValidatorSet(ctx, &tmservice.GetLatestValidatorSetRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return h.upstreamError(ctx, "GetLatestValidatorSet", err)
+			}
+
+			blockHeight, validators, pageResp = resp.GetBlockHeight(), resp.GetValidators(), resp.GetPagination()
+		} else {
+			resp, err := serviceClient.GetValidatorSetByHeight(ctx, &tmservice.GetValidatorSetByHeightRequest{
+				Height:     blockHeight,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return h.upstreamError(ctx, "GetValidatorSetByHeight", err)
+			}
+
+			validators, pageResp = resp.GetValidators(), resp.GetPagination()
+		}
+
+		fetched += uint64(len(validators))
+
+		if err := fn(blockHeight, remapValidators(validators)); err != nil {
+			return err
+		}
+
+		pagination, err = nextValidatorPage(pagination, pageResp, len(validators), fetched)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextValidatorPage returns the request for the page after the current one or nil after the last page.
+// Upstreams paginating by key return NextKey. The Cosmos SDK validator-set queries paginate by offset
+// only and never set NextKey, so the walk continues by offset until Total validators are fetched.
+// Pagination which would never end, like a repeated or empty key page or a set larger than
+// _maxValidatorSetSize, fails with DataLoss.
+func nextValidatorPage(
+	req *query.PageRequest,
+	resp *query.PageResponse,
+	pageLen int,
+	fetched uint64,
+) (*query.PageRequest, error) {
+	if fetched > _maxValidatorSetSize || resp.GetTotal() > _maxValidatorSetSize {
+		return nil, status.Errorf(codes.DataLoss,
+			"upstream validator-set exceeds %d validators", _maxValidatorSetSize)
+	}
+
+	switch {
+	case len(resp.GetNextKey()) > 0:
+		if pageLen == 0 || bytes.Equal(resp.GetNextKey(), req.Key) {
+			return nil, status.Error(codes.DataLoss, "upstream validator-set pagination does not advance")
+		}
+
+		return &query.PageRequest{Key: resp.GetNextKey(), Limit: req.Limit}, nil
+	case pageLen > 0 && fetched < resp.GetTotal():
+		return &query.PageRequest{Offset: fetched, Limit: req.Limit, CountTotal: true}, nil
+	default:
+		return nil, nil
+	}
+}
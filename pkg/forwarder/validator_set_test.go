@@ -0,0 +1,204 @@
+package forwarder_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+func newTestServiceClient(t *testing.T, conn grpc.ClientConnInterface) pb.ServiceClient {
+	t.Helper()
+
+	return newIndexedTestServiceClient(t, conn, nil)
+}
+
+// newIndexedTestServiceClient serves a ServiceHandler forwarding to conn and reading the local index from index.
+func newIndexedTestServiceClient(t *testing.T, conn grpc.ClientConnInterface, index *indexer.Store) pb.ServiceClient {
+	t.Helper()
+
+	logger := log.InitializeLogger("error", "json")
+	lis := bufconn.Listen(1024 * 1024)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterServiceServer(grpcServer, forwarder.NewServiceHandler(
+		newTestRouter(conn),
+		forwarder.NewTxDecoder(registry.NewInterfaceRegistry()),
+		forwarder.BlockRangeLimits{MaxConcurrency: 4},
+		index,
+		logger,
+	))
+
+	go grpcServer.Serve(lis) //nolint:errcheck
+
+	clientConn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		clientConn.Close()
+		grpcServer.Stop()
+	})
+
+	return pb.NewServiceClient(clientConn)
+}
+
+func TestGetFullValidatorSetPinsLatestHeight(t *testing.T) {
+	for _, byKey := range []bool{false, true} {
+		t.Run(fmt.Sprintf("byKey=%v", byKey), func(t *testing.T) {
+			ctx := context.Background()
+			fake := testrunner.NewFakeUpstream("test-1", 42, 250)
+			fake.UseNextKeys(byKey)
+			serviceClient := newTestServiceClient(t, fake.Conn(t))
+
+			resp, err := serviceClient.GetFullValidatorSet(ctx, &pb.GetFullValidatorSetRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.BlockHeight != 42 {
+				t.Errorf("expected block height 42, got %d", resp.BlockHeight)
+			}
+
+			if latest, byHeight := fake.Calls("GetLatestValidatorSet"), fake.Calls("GetValidatorSetByHeight"); latest != 1 || byHeight != 2 {
+				t.Errorf("expected 1 latest page and 2 pages by height, got %d and %d", latest, byHeight)
+			}
+
+			want, err := fake.GetValidatorSetByHeight(ctx, &tmservice.GetValidatorSetByHeightRequest{
+				Height:     42,
+				Pagination: &query.PageRequest{Limit: 250},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(resp.Validators) != 250 || resp.Validators[249].Address != want.Validators[249].Address {
+				t.Fatalf("expected all 250 validators in order, got %d", len(resp.Validators))
+			}
+		})
+	}
+}
+
+func TestGetFullValidatorSetStopsEndlessPagination(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		page *query.PageResponse
+	}{
+		{name: "repeated next key", page: &query.PageResponse{NextKey: []byte("same")}},
+		{name: "unreachable total", page: &query.PageResponse{Total: 1 << 40}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			fake := testrunner.NewFakeUpstream("test-1", 42, 3)
+			fake.OverridePagination(tc.page)
+
+			_, err := newTestServiceClient(t, fake.Conn(t)).GetFullValidatorSet(ctx, &pb.GetFullValidatorSetRequest{PageSize: 1})
+			if status.Code(err) != codes.DataLoss {
+				t.Fatalf("expected DataLoss, got %v", err)
+			}
+
+			if calls := fake.Calls("GetLatestValidatorSet") + fake.Calls("GetValidatorSetByHeight"); calls > 2 {
+				t.Errorf("expected the walk to stop after at most 2 pages, got %d", calls)
+			}
+		})
+	}
+}
+
+func TestValidatorIterator(t *testing.T) {
+	serviceClient := newTestServiceClient(t, testrunner.NewFakeUpstream("test-1", 42, 130).Conn(t))
+
+	it, err := client.NewValidatorIterator(context.Background(), serviceClient, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validators, err := it.All()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(validators) != 130 {
+		t.Errorf("expected 130 validators, got %d", len(validators))
+	}
+
+	if it.BlockHeight() != 7 {
+		t.Errorf("expected block height 7, got %d", it.BlockHeight())
+	}
+}
+
+func TestGetValidatorSetDiff(t *testing.T) {
+	validator := func(address string, power, priority int64) *tmservice.Validator {
+		return &tmservice.Validator{Address: address, VotingPower: power, ProposerPriority: priority}
+	}
+
+	from := []*tmservice.Validator{validator("d", 10, 0), validator("a", 10, -5), validator("b", 20, 5)}
+	to := []*tmservice.Validator{validator("a", 15, -5), validator("c", 30, 0), validator("d", 10, 0)}
+
+	// More validators than fit in a page make the diff depend on the full sets.
+	for i := 0; i < 150; i++ {
+		same := validator(fmt.Sprintf("z%03d", i), 1, 0)
+		from = append(from, same)
+		to = append(to, same)
+	}
+
+	fake := testrunner.NewFakeUpstream("test-1", 20, 0)
+	fake.SetValidatorsAt(10, from...)
+	fake.SetValidatorsAt(20, to...)
+
+	serviceClient := newTestServiceClient(t, fake.Conn(t))
+
+	resp, err := serviceClient.GetValidatorSetDiff(context.Background(), &pb.GetValidatorSetDiffRequest{FromHeight: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &pb.GetValidatorSetDiffResponse{
+		FromHeight: 10,
+		ToHeight:   20,
+		Added:      []*pb.Validator{{Address: "c", VotingPower: 30}},
+		Removed:    []*pb.Validator{{Address: "b", VotingPower: 20, ProposerPriority: 5}},
+		Changed: []*pb.ValidatorChange{{
+			Address:              "a",
+			FromVotingPower:      10,
+			ToVotingPower:        15,
+			VotingPowerDelta:     5,
+			FromProposerPriority: -5,
+			ToProposerPriority:   -5,
+		}},
+	}
+
+	if !proto.Equal(want, resp) {
+		t.Errorf("unexpected diff:\nwant %v\ngot  %v", want, resp)
+	}
+
+	_, err = serviceClient.GetValidatorSetDiff(context.Background(), &pb.GetValidatorSetDiffRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without from_height, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+const _blockRangeRetryBackoff = 200 * time.Millisecond
+
+// FetchBlockRange streams the blocks from fromHeight to toHeight inclusive with the GetBlockRange RPC
+// and passes them to fn in height order. When the stream breaks with Unavailable or Aborted, it calls
+// GetBlockRange again starting after the last delivered height, so no block is delivered twice.
+// maxRetries limits the number of reconnects in a row without any delivered block.
+func FetchBlockRange(
+	ctx context.Context,
+	serviceClient pb.ServiceClient,
+	fromHeight int64,
+	toHeight int64,
+	maxRetries int,
+	fn func(*pb.GetBlockRangeResponse) error,
+	opts ...grpc.CallOption,
+) error {
+	next := fromHeight
+	retries := 0
+
+	for next <= toHeight {
+		delivered, err := fetchBlockRange(ctx, serviceClient, next, toHeight, fn, opts...)
+		next += delivered
+
+		if err == nil {
+			return nil
+		}
+
+		if code := status.Code(err); code != codes.Unavailable && code != codes.Aborted {
+			return err
+		}
+
+		if delivered > 0 {
+			retries = 0
+		}
+
+		if retries >= maxRetries {
+			return err
+		}
+
+		retries++
+
+		select {
+		case <-time.After(time.Duration(retries) * _blockRangeRetryBackoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// fetchBlockRange runs a single GetBlockRange call and returns the number of delivered blocks.
+func fetchBlockRange(
+	ctx context.Context,
+	serviceClient pb.ServiceClient,
+	fromHeight int64,
+	toHeight int64,
+	fn func(*pb.GetBlockRangeResponse) error,
+	opts ...grpc.CallOption,
+) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := serviceClient.GetBlockRange(ctx, &pb.GetBlockRangeRequest{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	}, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	var delivered int64
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return delivered, nil
+		}
+
+		if err != nil {
+			return delivered, err
+		}
+
+		if err := fn(resp); err != nil {
+			return delivered, err
+		}
+
+		delivered++
+	}
+}
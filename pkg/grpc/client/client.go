@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
 	"google.golang.org/grpc/credentials/insecure"
@@ -35,22 +36,26 @@ func NewGRPCConn(
 }
 
 // NewDefaultGRPCConn is a constructor function with sane defaults for gRPC options and interceptors.
+// Additional interceptors run after the default ones, closest to the wire.
 func NewDefaultGRPCConn(
 	ctx context.Context,
 	logger log.Logger,
 	jsonConverter *jsonconv.JSONConverter,
+	interfaceRegistry codectypes.InterfaceRegistry,
 	serverAddr string,
+	interceptors ...grpc.UnaryClientInterceptor,
 ) (*grpc.ClientConn, error) {
 	return NewGRPCConn(
 		ctx,
 		serverAddr,
-		[]grpc.UnaryClientInterceptor{
+		append([]grpc.UnaryClientInterceptor{
+			NewRequestIDInterceptor(),
 			NewLoggingInterceptor(logger, jsonConverter),
-		},
+		}, interceptors...),
 		// The Cosmos SDK doesn't support any transport security mechanism.
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		// This instantiates a general gRPC codec which handles proto bytes. We pass in a nil interface registry
-		// if the request/response types contain interface instead of 'nil' you should pass the application specific codec.
-		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(nil).GRPCCodec())),
+		// This instantiates a general gRPC codec which handles proto bytes. The interface registry unpacks
+		// google.protobuf.Any values, like validator public keys, into their concrete types.
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(interfaceRegistry).GRPCCodec())),
 	)
 }
@@ -4,14 +4,41 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
 
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
+// NewRequestIDInterceptor is a gRPC client interceptor which propagates the request ID
+// of the incoming request to the outgoing x-request-id header.
+func NewRequestIDInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req any,
+		reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		requestID := logging.RequestIDFromContext(ctx)
+
+		if requestID != "" {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			if len(md.Get(logging.RequestIDHeader)) == 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, logging.RequestIDHeader, requestID)
+			}
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
 // NewLoggingInterceptor is a gRPC client interceptor for logging requests, responses and errors.
 func NewLoggingInterceptor(logger log.Logger, jsonConverter *jsonconv.JSONConverter) grpc.UnaryClientInterceptor {
 	return func(
@@ -23,21 +50,32 @@ func NewLoggingInterceptor(logger log.Logger, jsonConverter *jsonconv.JSONConver
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
+		// Skip rendering the bodies as JSON when the log statement would be dropped anyway.
+		if !logger.PrintEnabled() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		requestLogger := logger
+
+		if requestID := logging.RequestIDFromContext(ctx); requestID != "" {
+			requestLogger = logger.With(log.String("request_id", requestID))
+		}
+
 		start := time.Now()
 		errResp := invoker(ctx, method, req, reply, cc, opts...)
 		duration := time.Since(start)
 
 		reqJSON, err := jsonConverter.Marshal(req)
 		if err != nil {
-			logger.Error("error: request decoding: ", log.Error(errors.WithStack(err)))
+			requestLogger.Error("error: request decoding: ", log.Error(errors.WithStack(err)))
 		}
 
 		respJSON, err := jsonConverter.Marshal(reply)
 		if err != nil {
-			logger.Error("error: response decoding: ", log.Error(errors.WithStack(err)))
+			requestLogger.Error("error: response decoding: ", log.Error(errors.WithStack(err)))
 		}
 
-		logger.Print("outgoing gRPC request",
+		requestLogger.Print("outgoing gRPC request",
 			log.String("method", method),
 			log.String("request", string(reqJSON)),
 			log.String("response", string(respJSON)),
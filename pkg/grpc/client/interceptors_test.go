@@ -0,0 +1,52 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"google.golang.org/grpc"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+func BenchmarkLoggingInterceptor(b *testing.B) {
+	ctx := logging.NewRequestIDContext(context.Background(), "bench")
+	req := &tmservice.GetBlockByHeightRequest{Height: 1000}
+	resp := testrunner.NewFakeUpstream("bench-1", 1000, 175).LargeBlock(1000, 2000, 400)
+	method := "/cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight"
+
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		*reply.(*tmservice.GetBlockByHeightResponse) = *resp
+
+		return nil
+	}
+
+	for _, bc := range []struct {
+		name  string
+		level log.Level
+	}{
+		{name: "enabled", level: log.InfoLevel},
+		{name: "disabled", level: log.ErrorLevel},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			logger := log.New(log.WithLogToStdout(false), log.WithOutput(io.Discard), log.WithLevel(bc.level))
+			interceptor := client.NewLoggingInterceptor(logger, jsonconv.NewJSONConverter())
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				reply := &tmservice.GetBlockByHeightResponse{}
+				if err := interceptor(ctx, method, req, reply, nil, invoker); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
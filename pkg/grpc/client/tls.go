@@ -0,0 +1,63 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TLSConfig holds the transport security settings of a client connection.
+type TLSConfig struct {
+	// Enabled turns on TLS. The other settings are ignored without it.
+	Enabled bool
+	// CACertFile is a PEM file with the CAs to verify the server with. The system pool is used if empty.
+	CACertFile string
+	// CertFile and KeyFile are a PEM client certificate and key for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the server name verified against the certificate.
+	ServerName string
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
+}
+
+// TransportCredentials returns the transport credentials for the settings. Connections without TLS are insecure.
+func (c TLSConfig) TransportCredentials() (credentials.TransportCredentials, error) {
+	if !c.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	//nolint:gosec
+	tlsConfig := &tls.Config{
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if c.CACertFile != "" {
+		pem, err := os.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read CA certificate")
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in %s", c.CACertFile)
+		}
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot load client certificate")
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
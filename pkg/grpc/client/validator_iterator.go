@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+// ValidatorIterator iterates over the complete validator-set at a height using the
+// StreamValidatorSet RPC, so that callers don't have to deal with pagination.
+//
+//	it, err := client.NewValidatorIterator(ctx, serviceClient, 0)
+//	for {
+//		v, err := it.Next()
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		...
+//	}
+type ValidatorIterator struct {
+	stream      pb.Service_StreamValidatorSetClient
+	page        []*pb.Validator
+	blockHeight int64
+	err         error
+}
+
+// NewValidatorIterator starts streaming the validator-set at the given height. Zero means the latest height.
+func NewValidatorIterator(
+	ctx context.Context,
+	serviceClient pb.ServiceClient,
+	height int64,
+	opts ...grpc.CallOption,
+) (*ValidatorIterator, error) {
+	stream, err := serviceClient.StreamValidatorSet(ctx, &pb.GetFullValidatorSetRequest{Height: height}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidatorIterator{
+		stream: stream,
+	}, nil
+}
+
+// Next returns the next validator. It returns io.EOF after the last validator.
+func (it *ValidatorIterator) Next() (*pb.Validator, error) {
+	for len(it.page) == 0 {
+		if it.err != nil {
+			return nil, it.err
+		}
+
+		resp, err := it.stream.Recv()
+		if err != nil {
+			it.err = err
+
+			return nil, err
+		}
+
+		it.blockHeight = resp.GetBlockHeight()
+		it.page = resp.GetValidators()
+	}
+
+	v := it.page[0]
+	it.page = it.page[1:]
+
+	return v, nil
+}
+
+// BlockHeight returns the height the validator-set is pinned to. It is known after the first call to Next.
+func (it *ValidatorIterator) BlockHeight() int64 {
+	return it.blockHeight
+}
+
+// All drains the iterator and returns the remaining validators.
+func (it *ValidatorIterator) All() ([]*pb.Validator, error) {
+	validators := make([]*pb.Validator, 0)
+
+	for {
+		v, err := it.Next()
+		if err == io.EOF {
+			return validators, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, v)
+	}
+}
@@ -0,0 +1,34 @@
+package logging
+
+import (
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// InitializePolicy configures the gRPC logging policy from the application config.
+// Headers are logged together with bodies unless a method policy says otherwise.
+func InitializePolicy(conf *configs.Config, logger log.Logger) *Policy {
+	policy := NewDefaultPolicy()
+
+	policy.Default = MethodPolicy{
+		LogRequestBody:  conf.LogBodies,
+		LogResponseBody: conf.LogBodies,
+		LogHeaders:      conf.LogBodies,
+		MaxBodyBytes:    conf.LogMaxBodyBytes,
+		SampleRate:      conf.LogSampleRate,
+	}
+
+	if conf.LogRedactHeaders != nil {
+		policy.RedactHeaders = conf.LogRedactHeaders
+	}
+
+	methods, err := ParseMethodPolicies(conf.LogMethodPolicies, policy.Default)
+	if err != nil {
+		logger.Fatal("error: invalid LOG_METHOD_POLICIES: ",
+			log.Error(err), log.Strings("policies", conf.LogMethodPolicies))
+	}
+
+	policy.Methods = methods
+
+	return policy
+}
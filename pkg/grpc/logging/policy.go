@@ -0,0 +1,158 @@
+package logging
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const _redactedValue = "[REDACTED]"
+
+// MethodPolicy controls how the calls of a single gRPC method are logged.
+type MethodPolicy struct {
+	// LogRequestBody enables logging of the JSON encoded request.
+	LogRequestBody bool
+	// LogResponseBody enables logging of the JSON encoded response.
+	LogResponseBody bool
+	// LogHeaders enables logging of the JSON encoded, redacted request metadata.
+	LogHeaders bool
+	// MaxBodyBytes truncates logged bodies to the given size. Zero means no limit.
+	MaxBodyBytes int
+	// SampleRate is the fraction of successful calls which get logged, between 0 and 1.
+	// Failed calls are always logged.
+	SampleRate float64
+}
+
+// Sampled decides whether a successful call should be logged based on the sample rate.
+func (p MethodPolicy) Sampled() bool {
+	if p.SampleRate >= 1 {
+		return true
+	}
+
+	if p.SampleRate <= 0 {
+		return false
+	}
+
+	return rand.Float64() < p.SampleRate
+}
+
+// Truncate converts a body to string and cuts it to MaxBodyBytes if needed.
+func (p MethodPolicy) Truncate(body []byte) string {
+	if p.MaxBodyBytes <= 0 || len(body) <= p.MaxBodyBytes {
+		return string(body)
+	}
+
+	return fmt.Sprintf("%s...(truncated %d bytes)", body[:p.MaxBodyBytes], len(body)-p.MaxBodyBytes)
+}
+
+// Policy holds the default and per-method logging settings for gRPC interceptors.
+type Policy struct {
+	Default MethodPolicy
+	// Methods overrides the default settings per method. Keys are either full method names
+	// like "/api.cosmos.forwarder.v1.Service/GetBlockByHeight" or bare method names like "GetBlockByHeight".
+	Methods map[string]MethodPolicy
+	// RedactHeaders lists metadata keys whose values are replaced before logging.
+	RedactHeaders []string
+}
+
+// NewDefaultPolicy is a constructor function for a Policy which logs every call in full
+// and redacts credentials.
+func NewDefaultPolicy() *Policy {
+	return &Policy{
+		Default: MethodPolicy{
+			LogRequestBody:  true,
+			LogResponseBody: true,
+			LogHeaders:      true,
+			SampleRate:      1,
+		},
+		Methods:       make(map[string]MethodPolicy),
+		RedactHeaders: []string{"authorization", "cookie", "x-api-key"},
+	}
+}
+
+// ForMethod returns the logging settings for a full gRPC method name.
+func (p *Policy) ForMethod(fullMethod string) MethodPolicy {
+	if mp, ok := p.Methods[fullMethod]; ok {
+		return mp
+	}
+
+	if idx := strings.LastIndexByte(fullMethod, '/'); idx >= 0 {
+		if mp, ok := p.Methods[fullMethod[idx+1:]]; ok {
+			return mp
+		}
+	}
+
+	return p.Default
+}
+
+// RedactMetadata returns a copy of the metadata with the values of all redacted headers replaced.
+func (p *Policy) RedactMetadata(md metadata.MD) metadata.MD {
+	redacted := md.Copy()
+
+	for _, h := range p.RedactHeaders {
+		key := strings.ToLower(h)
+
+		if vals, ok := redacted[key]; ok {
+			for i := range vals {
+				vals[i] = _redactedValue
+			}
+		}
+	}
+
+	return redacted
+}
+
+// ParseMethodPolicies parses per-method overrides in the form
+// "Method:key=value,key=value". Unset keys are inherited from base.
+// Supported keys are request_body, response_body, bodies, headers, max_body_bytes and sample_rate.
+func ParseMethodPolicies(specs []string, base MethodPolicy) (map[string]MethodPolicy, error) {
+	policies := make(map[string]MethodPolicy, len(specs))
+
+	for _, spec := range specs {
+		method, settings, found := strings.Cut(spec, ":")
+		if !found || method == "" {
+			return nil, fmt.Errorf("not a valid method log policy: %q", spec)
+		}
+
+		mp := base
+
+		for _, setting := range strings.Split(settings, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(setting), "=")
+
+			if err := mp.set(key, value); err != nil {
+				return nil, fmt.Errorf("not a valid method log policy: %q: %w", spec, err)
+			}
+		}
+
+		policies[strings.TrimSpace(method)] = mp
+	}
+
+	return policies, nil
+}
+
+func (p *MethodPolicy) set(key, value string) error {
+	var err error
+
+	switch key {
+	case "request_body":
+		p.LogRequestBody, err = strconv.ParseBool(value)
+	case "response_body":
+		p.LogResponseBody, err = strconv.ParseBool(value)
+	case "bodies":
+		p.LogRequestBody, err = strconv.ParseBool(value)
+		p.LogResponseBody = p.LogRequestBody
+	case "headers":
+		p.LogHeaders, err = strconv.ParseBool(value)
+	case "max_body_bytes":
+		p.MaxBodyBytes, err = strconv.Atoi(value)
+	case "sample_rate":
+		p.SampleRate, err = strconv.ParseFloat(value, 64)
+	default:
+		err = fmt.Errorf("unknown setting %q", key)
+	}
+
+	return err
+}
@@ -0,0 +1,76 @@
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPolicyForMethod(t *testing.T) {
+	policy := logging.NewDefaultPolicy()
+
+	methods, err := logging.ParseMethodPolicies([]string{
+		"GetBlockByHeight:bodies=false,headers=false",
+		"/api.cosmos.forwarder.v1.Service/GetLatestValidatorSet:response_body=false,max_body_bytes=16,sample_rate=0.5",
+	}, policy.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy.Methods = methods
+
+	blockPolicy := policy.ForMethod("/api.cosmos.forwarder.v1.Service/GetBlockByHeight")
+	if blockPolicy.LogRequestBody || blockPolicy.LogResponseBody || blockPolicy.LogHeaders {
+		t.Errorf("expected bodies and headers to be disabled, got %+v", blockPolicy)
+	}
+
+	validatorsPolicy := policy.ForMethod("/api.cosmos.forwarder.v1.Service/GetLatestValidatorSet")
+	if !validatorsPolicy.LogRequestBody || validatorsPolicy.LogResponseBody ||
+		validatorsPolicy.MaxBodyBytes != 16 || validatorsPolicy.SampleRate != 0.5 {
+		t.Errorf("unexpected method policy: %+v", validatorsPolicy)
+	}
+
+	if got := policy.ForMethod("/api.cosmos.forwarder.v1.Service/GetSyncing"); got != policy.Default {
+		t.Errorf("expected default policy, got %+v", got)
+	}
+}
+
+func TestParseMethodPoliciesInvalid(t *testing.T) {
+	for _, spec := range []string{"GetSyncing", ":bodies=false", "GetSyncing:unknown=1", "GetSyncing:sample_rate=x"} {
+		if _, err := logging.ParseMethodPolicies([]string{spec}, logging.MethodPolicy{}); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
+
+func TestMethodPolicyTruncate(t *testing.T) {
+	mp := logging.MethodPolicy{MaxBodyBytes: 4}
+
+	if got := mp.Truncate([]byte(`{"a":1}`)); got != `{"a"...(truncated 3 bytes)` {
+		t.Errorf("unexpected truncated body: %s", got)
+	}
+
+	if got := mp.Truncate([]byte(`{}`)); got != `{}` {
+		t.Errorf("unexpected body: %s", got)
+	}
+}
+
+func TestPolicyRedactMetadata(t *testing.T) {
+	policy := logging.NewDefaultPolicy()
+	md := metadata.Pairs("Authorization", "Bearer secret", "x-request-id", "abc")
+
+	redacted := policy.RedactMetadata(md)
+
+	if got := redacted.Get("authorization"); len(got) != 1 || got[0] != "[REDACTED]" {
+		t.Errorf("expected authorization to be redacted, got %v", got)
+	}
+
+	if got := redacted.Get("x-request-id"); len(got) != 1 || got[0] != "abc" {
+		t.Errorf("expected x-request-id to be kept, got %v", got)
+	}
+
+	if got := md.Get("authorization"); got[0] != "Bearer secret" {
+		t.Errorf("expected original metadata to be untouched, got %v", got)
+	}
+}
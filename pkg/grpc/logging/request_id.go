@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// RequestIDHeader is the metadata key used to propagate request IDs.
+	RequestIDHeader = "x-request-id"
+	// MaxRequestIDLength is the maximum length of a propagated request ID.
+	// Longer request IDs are replaced with generated ones.
+	MaxRequestIDLength = 128
+)
+
+type requestIDContextKey struct{}
+
+// NewRequestID generates a random request ID.
+func NewRequestID() string {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// NewRequestIDContext returns a copy of ctx which carries the request ID.
+func NewRequestIDContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx or an empty string.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+
+	return requestID
+}
+
+// RequestIDFromMetadata returns the first request ID found in metadata or an empty string.
+func RequestIDFromMetadata(md metadata.MD) string {
+	if vals := md.Get(RequestIDHeader); len(vals) > 0 {
+		return vals[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,76 @@
+package replay
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+const _fixtureExt = ".json"
+
+// Fixture is a single recorded upstream call. Request and Response hold the proto wire encoding
+// and are what replay relies on. RequestJSON and ResponseJSON only make fixtures readable in diffs.
+type Fixture struct {
+	Method       string          `json:"method"`
+	Request      []byte          `json:"request"`
+	Response     []byte          `json:"response,omitempty"`
+	Code         codes.Code      `json:"code"`
+	Message      string          `json:"message,omitempty"`
+	RequestJSON  json.RawMessage `json:"request_json,omitempty"`
+	ResponseJSON json.RawMessage `json:"response_json,omitempty"`
+}
+
+// FixtureName returns the file name of the fixture for a call, e.g.
+// cosmos.base.tendermint.v1beta1.Service_GetBlockByHeight-1f2e3d4c5b6a7988.json.
+// Calls of the same method with different requests get different fixtures.
+func FixtureName(method string, request []byte) string {
+	sum := sha256.Sum256(request)
+
+	return strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "_") + "-" + hex.EncodeToString(sum[:8]) + _fixtureExt
+}
+
+// Save writes the fixture to dir, replacing an earlier recording of the same call.
+func (f *Fixture) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.WriteFile(filepath.Join(dir, FixtureName(f.Method, f.Request)), append(b, '\n'), 0o644))
+}
+
+// LoadFixtures reads all fixtures in dir keyed by their FixtureName.
+func LoadFixtures(dir string) (map[string]*Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+_fixtureExt))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	fixtures := make(map[string]*Fixture, len(paths))
+
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		var f Fixture
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, errors.Wrapf(err, "invalid fixture %s", path)
+		}
+
+		fixtures[FixtureName(f.Method, f.Request)] = &f
+	}
+
+	return fixtures, nil
+}
@@ -0,0 +1,82 @@
+package replay
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// NewRecordingInterceptor is a gRPC client interceptor which records every call together with
+// its response or error status as a fixture in dir. Recording never fails the call itself.
+func NewRecordingInterceptor(
+	dir string,
+	jsonConverter *jsonconv.JSONConverter,
+	logger log.Logger,
+) grpc.UnaryClientInterceptor {
+	var mu sync.Mutex
+
+	return func(
+		ctx context.Context,
+		method string,
+		req any,
+		reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		errResp := invoker(ctx, method, req, reply, cc, opts...)
+
+		fixture, err := newFixture(method, req, reply, errResp, jsonConverter)
+		if err == nil {
+			mu.Lock()
+			err = fixture.Save(dir)
+			mu.Unlock()
+		}
+
+		if err != nil {
+			logger.Warn("cannot record upstream call", log.String("method", method), log.Error(err))
+		}
+
+		return errResp
+	}
+}
+
+func newFixture(method string, req any, reply any, errResp error, jsonConverter *jsonconv.JSONConverter) (*Fixture, error) {
+	reqBytes, err := proto.Marshal(req.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+
+	st := status.Convert(errResp)
+
+	fixture := &Fixture{
+		Method:  method,
+		Request: reqBytes,
+		Code:    st.Code(),
+		Message: st.Message(),
+	}
+
+	if reqJSON, err := jsonConverter.Marshal(req); err == nil {
+		fixture.RequestJSON = reqJSON
+	}
+
+	if errResp != nil {
+		return fixture, nil
+	}
+
+	if fixture.Response, err = proto.Marshal(reply.(proto.Message)); err != nil {
+		return nil, err
+	}
+
+	if respJSON, err := jsonConverter.Marshal(reply); err == nil {
+		fixture.ResponseJSON = respJSON
+	}
+
+	return fixture, nil
+}
@@ -0,0 +1,98 @@
+package replay_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/replay"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+const (
+	_methodGetSyncing       = "/cosmos.base.tendermint.v1beta1.Service/GetSyncing"
+	_methodGetBlockByHeight = "/cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger := log.InitializeLogger("error", "json")
+
+	record := replay.NewRecordingInterceptor(dir, jsonconv.NewJSONConverter(), logger)
+
+	err := record(ctx, _methodGetSyncing, &tmservice.GetSyncingRequest{}, &tmservice.GetSyncingResponse{}, nil,
+		func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			reply.(*tmservice.GetSyncingResponse).Syncing = true
+
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = record(ctx, _methodGetBlockByHeight, &tmservice.GetBlockByHeightRequest{Height: 99},
+		&tmservice.GetBlockByHeightResponse{}, nil,
+		func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return status.Error(codes.InvalidArgument, "requested block height is bigger then the chain length")
+		})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected the upstream error to be passed through, got %v", err)
+	}
+
+	replayServer, err := replay.NewServer(dir, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if replayServer.Len() != 2 {
+		t.Fatalf("expected 2 fixtures, got %d", replayServer.Len())
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+
+	go replayServer.Serve(lis) //nolint:errcheck
+	defer replayServer.Stop()
+
+	conn, err := client.NewGRPCConn(ctx, "bufnet", nil,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(registry.NewInterfaceRegistry()).GRPCCodec())),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	serviceClient := tmservice.NewServiceClient(conn)
+
+	syncing, err := serviceClient.GetSyncing(ctx, &tmservice.GetSyncingRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !syncing.Syncing {
+		t.Error("expected the recorded syncing flag to be replayed")
+	}
+
+	_, err = serviceClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 99})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected the recorded InvalidArgument status, got %v", err)
+	}
+
+	_, err = serviceClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 100})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented for a call without fixture, got %v", err)
+	}
+}
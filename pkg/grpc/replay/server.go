@@ -0,0 +1,112 @@
+package replay
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// Server is a stand-in gRPC upstream which answers unary calls from recorded fixtures.
+// A call is matched by its method and the exact bytes of its request, and receives the
+// recorded response or error status. Calls without a fixture fail with Unimplemented.
+type Server struct {
+	fixtures   map[string]*Fixture
+	grpcServer *grpc.Server
+	logger     log.Logger
+}
+
+// NewServer loads the fixtures in dir and creates a replay server for them.
+func NewServer(dir string, logger log.Logger) (*Server, error) {
+	fixtures, err := LoadFixtures(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		fixtures: fixtures,
+		logger:   logger,
+	}
+
+	s.grpcServer = grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(s.handle),
+	)
+
+	return s, nil
+}
+
+// Len returns the number of loaded fixtures.
+func (s *Server) Len() int {
+	return len(s.fixtures)
+}
+
+// Serve accepts connections on lis until Stop is called.
+func (s *Server) Serve(lis net.Listener) error {
+	return s.grpcServer.Serve(lis)
+}
+
+// Stop stops the server and closes all open connections.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+func (s *Server) handle(_ any, stream grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "replay: missing method")
+	}
+
+	var req rawMessage
+	if err := stream.RecvMsg(&req); err != nil {
+		return err
+	}
+
+	fixture, ok := s.fixtures[FixtureName(method, req)]
+	if !ok {
+		s.logger.Warn("no fixture recorded for call", log.String("method", method))
+
+		return status.Errorf(codes.Unimplemented, "replay: no fixture recorded for %s with this request", method)
+	}
+
+	if fixture.Code != codes.OK {
+		return status.Error(fixture.Code, fixture.Message)
+	}
+
+	return stream.SendMsg(rawMessage(fixture.Response))
+}
+
+// rawMessage is a message passed through the replay server in its wire encoding.
+type rawMessage []byte
+
+// rawCodec sends and receives rawMessage values without decoding them.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(rawMessage)
+	if !ok {
+		return nil, errors.Errorf("replay: cannot marshal %T", v)
+	}
+
+	return msg, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(*rawMessage)
+	if !ok {
+		return errors.Errorf("replay: cannot unmarshal into %T", v)
+	}
+
+	*msg = append((*msg)[:0], data...)
+
+	return nil
+}
+
+// Name returns the name of the default gRPC codec, since the payload is plain proto.
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -4,66 +4,382 @@ import (
 	"context"
 	"time"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/pkg/errors"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
 	"google.golang.org/grpc"
 )
 
+// NewRequestIDInterceptor is a gRPC server interceptor which propagates the x-request-id header
+// or generates a new request ID if the header is missing. The request ID is echoed back in
+// the response headers and added to the request-scoped logger stored in the context.
+func NewRequestIDInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		invoker grpc.UnaryHandler,
+	) (resp any, err error) {
+		ctx, requestID, requestLogger := newRequestContext(ctx, logger)
+
+		if err := grpc.SetHeader(ctx, metadata.Pairs(logging.RequestIDHeader, requestID)); err != nil {
+			requestLogger.Warn("error: cannot set request ID response header: ", log.Error(err))
+		}
+
+		return invoker(ctx, req)
+	}
+}
+
+// NewStreamRequestIDInterceptor is the streaming counterpart of NewRequestIDInterceptor.
+func NewStreamRequestIDInterceptor(logger log.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, requestID, requestLogger := newRequestContext(ss.Context(), logger)
+
+		if err := ss.SetHeader(metadata.Pairs(logging.RequestIDHeader, requestID)); err != nil {
+			requestLogger.Warn("error: cannot set request ID response header: ", log.Error(err))
+		}
+
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		return handler(srv, wrapped)
+	}
+}
+
+// newRequestContext resolves the request ID of an incoming call and stores it
+// together with a request-scoped logger in the returned context.
+func newRequestContext(ctx context.Context, logger log.Logger) (context.Context, string, log.Logger) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := logging.RequestIDFromMetadata(md)
+	if requestID == "" || len(requestID) > logging.MaxRequestIDLength {
+		requestID = logging.NewRequestID()
+	}
+
+	requestLogger := logger.With(log.String("request_id", requestID))
+
+	ctx = logging.NewRequestIDContext(ctx, requestID)
+	ctx = log.NewContext(ctx, requestLogger)
+
+	return ctx, requestID, requestLogger
+}
+
 // NewLoggingInterceptor is a gRPC server interceptor for logging requests, responses and errors.
-func NewLoggingInterceptor(logger log.Logger, jsonConverter *jsonconv.JSONConverter) grpc.UnaryServerInterceptor {
+// What gets logged for each method is controlled by the logging policy.
+func NewLoggingInterceptor(
+	logger log.Logger,
+	jsonConverter *jsonconv.JSONConverter,
+	policy *logging.Policy,
+) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req any,
 		info *grpc.UnaryServerInfo,
 		invoker grpc.UnaryHandler,
 	) (resp any, err error) {
+		methodPolicy := policy.ForMethod(info.FullMethod)
+		requestLogger := log.FromContext(ctx, logger)
+
+		// A panicking handler fails only its own call with Internal instead of crashing the process.
 		defer func() {
-			if err := recover(); err != nil {
-				reqJSON, err := jsonConverter.Marshal(req)
-				if err != nil {
-					logger.Error("error: request decoding: ", log.Error(errors.WithStack(err)))
+			if r := recover(); r != nil {
+				reqJSON, marshalErr := jsonConverter.Marshal(req)
+				if marshalErr != nil {
+					requestLogger.Error("error: request decoding: ", log.Error(errors.WithStack(marshalErr)))
 				}
 
-				logger.Panic("panicked gRPC request",
+				requestLogger.Error("panicked gRPC request",
 					log.String("method", info.FullMethod),
-					log.String("request", string(reqJSON)),
-					log.Error(err),
+					log.String("request", methodPolicy.Truncate(reqJSON)),
+					log.Any("panic", r),
+					log.StackSkip("stack", 2),
 				)
+
+				resp, err = nil, status.Error(codes.Internal, "internal error")
 			}
 		}()
 
+		// Rendering bodies and headers as JSON is by far the most expensive part of a call,
+		// so nothing is rendered when the log statement would be dropped anyway.
+		if !requestLogger.PrintEnabled() {
+			return invoker(ctx, req)
+		}
+
 		start := time.Now()
 		handlerResp, errResp := invoker(ctx, req)
 		duration := time.Since(start)
 
-		reqJSON, err := jsonConverter.Marshal(req)
-		if err != nil {
-			logger.Error("error: request decoding: ", log.Error(errors.WithStack(err)))
+		if errResp == nil && !methodPolicy.Sampled() {
+			return handlerResp, errResp
 		}
 
-		respJSON, err := jsonConverter.Marshal(handlerResp)
-		if err != nil {
-			logger.Error("error: response decoding: ", log.Error(errors.WithStack(err)))
+		fields := []log.Field{
+			log.String("method", info.FullMethod),
 		}
 
-		md, _ := metadata.FromIncomingContext(ctx)
+		if methodPolicy.LogRequestBody {
+			reqJSON, err := jsonConverter.Marshal(req)
+			if err != nil {
+				requestLogger.Error("error: request decoding: ", log.Error(errors.WithStack(err)))
+			}
+
+			fields = append(fields, log.String("request", methodPolicy.Truncate(reqJSON)))
+		}
+
+		if methodPolicy.LogResponseBody {
+			respJSON, err := jsonConverter.Marshal(handlerResp)
+			if err != nil {
+				requestLogger.Error("error: response decoding: ", log.Error(errors.WithStack(err)))
+			}
 
-		headers, err := jsonConverter.Marshal(md)
-		if err != nil {
-			logger.Error("error: headers decoding: ", log.Error(errors.WithStack(err)))
+			fields = append(fields, log.String("response", methodPolicy.Truncate(respJSON)))
 		}
 
-		logger.Print("gRPC request",
-			log.String("request", string(reqJSON)),
-			log.String("response", string(respJSON)),
+		if methodPolicy.LogHeaders {
+			md, _ := metadata.FromIncomingContext(ctx)
+
+			headers, err := jsonConverter.Marshal(policy.RedactMetadata(md))
+			if err != nil {
+				requestLogger.Error("error: headers decoding: ", log.Error(errors.WithStack(err)))
+			}
+
+			fields = append(fields, log.String("headers", string(headers)))
+		}
+
+		fields = append(fields,
 			log.Error(errResp),
 			log.Float64("duration", duration.Seconds()),
-			log.String("headers", string(headers)),
 		)
 
+		requestLogger.Print("gRPC request", fields...)
+
+		return handlerResp, errResp
+	}
+}
+
+// NewStreamLoggingInterceptor is a gRPC server interceptor for logging streaming calls.
+// Message bodies are not logged for streams; the number of received and sent messages is logged instead.
+func NewStreamLoggingInterceptor(
+	logger log.Logger,
+	jsonConverter *jsonconv.JSONConverter,
+	policy *logging.Policy,
+) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		ctx := ss.Context()
+		methodPolicy := policy.ForMethod(info.FullMethod)
+		requestLogger := log.FromContext(ctx, logger)
+
+		defer func() {
+			if r := recover(); r != nil {
+				requestLogger.Error("panicked gRPC stream",
+					log.String("method", info.FullMethod),
+					log.Any("panic", r),
+					log.StackSkip("stack", 2),
+				)
+
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		if !requestLogger.PrintEnabled() {
+			return handler(srv, ss)
+		}
+
+		counted := &countingServerStream{ServerStream: ss}
+
+		start := time.Now()
+		errResp := handler(srv, counted)
+		duration := time.Since(start)
+
+		if errResp == nil && !methodPolicy.Sampled() {
+			return errResp
+		}
+
+		fields := []log.Field{
+			log.String("method", info.FullMethod),
+			log.Int("received_messages", counted.received),
+			log.Int("sent_messages", counted.sent),
+			log.Error(errResp),
+			log.Float64("duration", duration.Seconds()),
+		}
+
+		if methodPolicy.LogHeaders {
+			md, _ := metadata.FromIncomingContext(ctx)
+
+			headers, marshalErr := jsonConverter.Marshal(policy.RedactMetadata(md))
+			if marshalErr != nil {
+				requestLogger.Error("error: headers decoding: ", log.Error(errors.WithStack(marshalErr)))
+			}
+
+			fields = append(fields, log.String("headers", string(headers)))
+		}
+
+		requestLogger.Print("gRPC stream", fields...)
+
+		return errResp
+	}
+}
+
+// NewAccessLogInterceptor is a gRPC server interceptor which writes one access log entry per call.
+// Access log entries have a stable schema so that they can be shipped to a separate sink:
+// request_id, method, peer, user_agent, code, error, duration_ms, request_bytes and response_bytes.
+// Successful calls are logged with Info level and failed ones with Warn level.
+func NewAccessLogInterceptor(accessLogger log.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		invoker grpc.UnaryHandler,
+	) (resp any, err error) {
+		start := time.Now()
+		handlerResp, errResp := invoker(ctx, req)
+		duration := time.Since(start)
+
+		var peerAddr, userAgent, errMsg string
+
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			peerAddr = p.Addr.String()
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		if vals := md.Get("user-agent"); len(vals) > 0 {
+			userAgent = vals[0]
+		}
+
+		st := status.Convert(errResp)
+		if errResp != nil {
+			errMsg = st.Message()
+		}
+
+		fields := []log.Field{
+			log.String("request_id", logging.RequestIDFromContext(ctx)),
+			log.String("method", info.FullMethod),
+			log.String("peer", peerAddr),
+			log.String("user_agent", userAgent),
+			log.String("code", st.Code().String()),
+			log.String("error", errMsg),
+			log.Float64("duration_ms", float64(duration)/float64(time.Millisecond)),
+			log.Int("request_bytes", messageSize(req)),
+			log.Int("response_bytes", messageSize(handlerResp)),
+		}
+
+		if errResp != nil {
+			accessLogger.Warn("access", fields...)
+		} else {
+			accessLogger.Info("access", fields...)
+		}
+
 		return handlerResp, errResp
 	}
 }
+
+// NewStreamAccessLogInterceptor is the streaming counterpart of NewAccessLogInterceptor.
+// request_bytes and response_bytes are summed over all messages of the stream.
+func NewStreamAccessLogInterceptor(accessLogger log.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		counted := &countingServerStream{ServerStream: ss}
+
+		start := time.Now()
+		errResp := handler(srv, counted)
+		duration := time.Since(start)
+
+		ctx := ss.Context()
+
+		var peerAddr, userAgent, errMsg string
+
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			peerAddr = p.Addr.String()
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		if vals := md.Get("user-agent"); len(vals) > 0 {
+			userAgent = vals[0]
+		}
+
+		st := status.Convert(errResp)
+		if errResp != nil {
+			errMsg = st.Message()
+		}
+
+		fields := []log.Field{
+			log.String("request_id", logging.RequestIDFromContext(ctx)),
+			log.String("method", info.FullMethod),
+			log.String("peer", peerAddr),
+			log.String("user_agent", userAgent),
+			log.String("code", st.Code().String()),
+			log.String("error", errMsg),
+			log.Float64("duration_ms", float64(duration)/float64(time.Millisecond)),
+			log.Int("request_bytes", counted.receivedBytes),
+			log.Int("response_bytes", counted.sentBytes),
+		}
+
+		if errResp != nil {
+			accessLogger.Warn("access", fields...)
+		} else {
+			accessLogger.Info("access", fields...)
+		}
+
+		return errResp
+	}
+}
+
+// countingServerStream counts the messages and bytes going through a server stream.
+type countingServerStream struct {
+	grpc.ServerStream
+	received      int
+	sent          int
+	receivedBytes int
+	sentBytes     int
+}
+
+func (s *countingServerStream) SendMsg(m any) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	s.sent++
+	s.sentBytes += messageSize(m)
+
+	return nil
+}
+
+func (s *countingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.received++
+	s.receivedBytes += messageSize(m)
+
+	return nil
+}
+
+func messageSize(msg any) int {
+	if m, ok := msg.(interface{ Size() int }); ok {
+		return m.Size()
+	}
+
+	return 0
+}
@@ -0,0 +1,262 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/server"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type headerRecorder struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (r *headerRecorder) Method() string {
+	return "/test.Service/Method"
+}
+
+func (r *headerRecorder) SetHeader(md metadata.MD) error {
+	r.header = metadata.Join(r.header, md)
+
+	return nil
+}
+
+func TestRequestIDInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(log.WithLogToStdout(false), log.WithOutput(&buf))
+	interceptor := server.NewRequestIDInterceptor(logger)
+
+	for name, incoming := range map[string]metadata.MD{
+		"propagated": metadata.Pairs(logging.RequestIDHeader, "req-123"),
+		"generated":  metadata.MD{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf.Reset()
+
+			stream := &headerRecorder{}
+			ctx := metadata.NewIncomingContext(context.Background(), incoming)
+			ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+
+			var requestID string
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: stream.Method()},
+				func(ctx context.Context, req any) (any, error) {
+					requestID = logging.RequestIDFromContext(ctx)
+					log.FromContext(ctx, nil).Info("handled")
+
+					return nil, nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if requestID == "" {
+				t.Fatal("expected a request ID in the context")
+			}
+
+			if want := logging.RequestIDFromMetadata(incoming); want != "" && requestID != want {
+				t.Errorf("expected request ID %q, got %q", want, requestID)
+			}
+
+			if got := logging.RequestIDFromMetadata(stream.header); got != requestID {
+				t.Errorf("expected response header %q, got %q", requestID, got)
+			}
+
+			if !strings.Contains(buf.String(), `"request_id":"`+requestID+`"`) {
+				t.Errorf("expected request ID in log output, got %s", buf.String())
+			}
+		})
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+	sent   int
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+
+	return nil
+}
+
+func (s *fakeServerStream) SendMsg(m any) error {
+	s.sent++
+
+	return nil
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(log.WithLogToStdout(false), log.WithOutput(&buf))
+	stream := &fakeServerStream{
+		ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(logging.RequestIDHeader, "req-123")),
+	}
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream", IsServerStream: true}
+
+	requestID := server.NewStreamRequestIDInterceptor(logger)
+	accessLog := server.NewStreamAccessLogInterceptor(logger)
+
+	err := requestID(nil, stream, info, func(srv any, ss grpc.ServerStream) error {
+		return accessLog(srv, ss, info, func(srv any, ss grpc.ServerStream) error {
+			if got := logging.RequestIDFromContext(ss.Context()); got != "req-123" {
+				t.Errorf("expected request ID %q in the stream context, got %q", "req-123", got)
+			}
+
+			for i := 0; i < 3; i++ {
+				if err := ss.SendMsg(nil); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := logging.RequestIDFromMetadata(stream.header); got != "req-123" {
+		t.Errorf("expected response header %q, got %q", "req-123", got)
+	}
+
+	if stream.sent != 3 {
+		t.Errorf("expected 3 sent messages, got %d", stream.sent)
+	}
+
+	if !strings.Contains(buf.String(), `"request_id":"req-123"`) || !strings.Contains(buf.String(), `"code":"OK"`) {
+		t.Errorf("expected access log entry for the stream, got %s", buf.String())
+	}
+}
+
+func TestLoggingInterceptorRecoversPanics(t *testing.T) {
+	for _, level := range []log.Level{log.InfoLevel, log.ErrorLevel} {
+		t.Run(level.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+
+			logger := log.New(log.WithLogToStdout(false), log.WithOutput(&buf), log.WithLevel(level))
+			interceptor := server.NewLoggingInterceptor(logger, jsonconv.NewJSONConverter(), logging.NewDefaultPolicy())
+			info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+			resp, err := interceptor(context.Background(), &tmservice.GetBlockByHeightRequest{Height: 7}, info,
+				func(ctx context.Context, req any) (any, error) {
+					panic("boom")
+				})
+
+			if resp != nil || status.Code(err) != codes.Internal {
+				t.Fatalf("expected an Internal error, got %v %v", resp, err)
+			}
+
+			for _, want := range []string{`"msg":"panicked gRPC request"`, `"panic":"boom"`, `"request":"{\"height\":\"7\"}"`} {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("expected log to contain %s, got %s", want, buf.String())
+				}
+			}
+
+			buf.Reset()
+
+			streamInterceptor := server.NewStreamLoggingInterceptor(logger, jsonconv.NewJSONConverter(),
+				logging.NewDefaultPolicy())
+			streamInfo := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream", IsServerStream: true}
+
+			err = streamInterceptor(nil, &fakeServerStream{ctx: context.Background()}, streamInfo,
+				func(srv any, ss grpc.ServerStream) error {
+					panic("boom")
+				})
+
+			if status.Code(err) != codes.Internal {
+				t.Fatalf("expected an Internal stream error, got %v", err)
+			}
+
+			if !strings.Contains(buf.String(), `"msg":"panicked gRPC stream"`) {
+				t.Errorf("expected a panicked stream log entry, got %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestLoggingInterceptorHeadersPolicy(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "test"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	for _, logHeaders := range []bool{true, false} {
+		var buf bytes.Buffer
+
+		policy := logging.NewDefaultPolicy()
+		policy.Default = logging.MethodPolicy{LogHeaders: logHeaders, SampleRate: 1}
+
+		logger := log.New(log.WithLogToStdout(false), log.WithOutput(&buf))
+		interceptor := server.NewLoggingInterceptor(logger, jsonconv.NewJSONConverter(), policy)
+
+		_, err := interceptor(ctx, &tmservice.GetBlockByHeightRequest{Height: 7}, info,
+			func(ctx context.Context, req any) (any, error) {
+				return &tmservice.GetBlockByHeightResponse{}, nil
+			})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := strings.Contains(buf.String(), `"headers"`); got != logHeaders {
+			t.Errorf("expected headers logged to be %t, got %s", logHeaders, buf.String())
+		}
+
+		if strings.Contains(buf.String(), `"request"`) || strings.Contains(buf.String(), `"response"`) {
+			t.Errorf("expected no bodies to be logged, got %s", buf.String())
+		}
+	}
+}
+
+func BenchmarkLoggingInterceptor(b *testing.B) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "bench"))
+	req := &tmservice.GetBlockByHeightRequest{Height: 1000}
+	resp := testrunner.NewFakeUpstream("bench-1", 1000, 175).LargeBlock(1000, 2000, 400)
+	info := &grpc.UnaryServerInfo{FullMethod: "/cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight"}
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return resp, nil
+	}
+
+	for _, bc := range []struct {
+		name  string
+		level log.Level
+	}{
+		{name: "enabled", level: log.InfoLevel},
+		{name: "disabled", level: log.ErrorLevel},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			logger := log.New(log.WithLogToStdout(false), log.WithOutput(io.Discard), log.WithLevel(bc.level))
+			interceptor := server.NewLoggingInterceptor(logger, jsonconv.NewJSONConverter(), logging.NewDefaultPolicy())
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := interceptor(ctx, req, info, handler); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
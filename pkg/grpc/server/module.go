@@ -7,16 +7,19 @@ import (
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
 
 	"google.golang.org/grpc"
 )
 
 // InitialiazeNewGRPCServer initializes the gRPC server module.
+// Calls are written to accessLogger, unless it is nil.
 func InitialiazeNewGRPCServer(
 	ctx context.Context,
 	conf *configs.Config,
 	logger log.Logger,
+	accessLogger *log.StructuredLogger,
 	jsonConverter *jsonconv.JSONConverter,
 ) *Server {
 	serverAddress := fmt.Sprintf("%s:%d", conf.ServerHost, conf.ServerPort)
@@ -26,13 +29,60 @@ func InitialiazeNewGRPCServer(
 		logger.Panic("error: cannot create server listener: ", log.Error(err))
 	}
 
+	policy := logging.InitializePolicy(conf, logger.Named("grpc.server"))
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		NewRequestIDInterceptor(logger.Named("grpc.server")),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		NewStreamRequestIDInterceptor(logger.Named("grpc.server")),
+	}
+
+	if accessLogger != nil {
+		interceptors = append(interceptors, NewAccessLogInterceptor(accessLogger.Named("access")))
+		streamInterceptors = append(streamInterceptors, NewStreamAccessLogInterceptor(accessLogger.Named("access")))
+	}
+
+	interceptors = append(interceptors,
+		NewLoggingInterceptor(logger.Named("grpc.server"), jsonConverter, policy),
+	)
+	streamInterceptors = append(streamInterceptors,
+		NewStreamLoggingInterceptor(logger.Named("grpc.server"), jsonConverter, policy),
+	)
+
 	return NewGRPCServer(
 		conf.ServerName,
 		serverAddress,
 		lis,
 		logger,
+		interceptors,
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+}
+
+// InitializeNewAdminGRPCServer initializes the admin gRPC server module.
+// The admin server runs on its own listener so that it can be kept private.
+func InitializeNewAdminGRPCServer(
+	ctx context.Context,
+	conf *configs.Config,
+	logger log.Logger,
+	jsonConverter *jsonconv.JSONConverter,
+) *Server {
+	serverAddress := fmt.Sprintf("%s:%d", conf.AdminServerHost, conf.AdminServerPort)
+
+	lis, err := NewListener(serverAddress)
+	if err != nil {
+		logger.Panic("error: cannot create admin server listener: ", log.Error(err))
+	}
+
+	return NewGRPCServer(
+		conf.ServerName+"-admin",
+		serverAddress,
+		lis,
+		logger,
 		[]grpc.UnaryServerInterceptor{
-			NewLoggingInterceptor(logger, jsonConverter),
+			NewRequestIDInterceptor(logger.Named("grpc.admin")),
+			NewLoggingInterceptor(logger.Named("grpc.admin"), jsonConverter, logging.InitializePolicy(conf, logger.Named("grpc.admin"))),
 		},
 	)
 }
@@ -0,0 +1,44 @@
+package testrunner
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+)
+
+// SetCheckTxCode sets the CheckTx code returned for broadcast transactions. Zero accepts them.
+func (f *FakeUpstream) SetCheckTxCode(code uint32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.checkTxCode = code
+}
+
+// fakeTxService serves the cosmos.tx.v1beta1.Service broadcasts of a FakeUpstream.
+// Broadcasts are counted and fail through FailWith under the method name "BroadcastTx".
+type fakeTxService struct {
+	txtypes.UnimplementedServiceServer
+
+	fake *FakeUpstream
+}
+
+// BroadcastTx implements txtypes.ServiceServer.
+func (s *fakeTxService) BroadcastTx(
+	ctx context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	if err := s.fake.call(ctx, "BroadcastTx"); err != nil {
+		return nil, err
+	}
+
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	return &txtypes.BroadcastTxResponse{
+		TxResponse: &sdk.TxResponse{
+			TxHash: indexer.TxHash(req.TxBytes),
+			Code:   s.fake.checkTxCode,
+		},
+	}, nil
+}
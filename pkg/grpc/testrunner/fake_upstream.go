@@ -0,0 +1,604 @@
+package testrunner
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	cmtp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+const (
+	_fakeCosmosSDKVersion = "v0.47.2"
+	_fakeBlockInterval    = 5 * time.Second
+)
+
+// _fakeGenesisTime is the time of the first synthetic block. Later blocks follow every _fakeBlockInterval.
+var _fakeGenesisTime = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// FakeUpstream is a programmable in-process cosmos.base.tendermint.v1beta1.Service which serves
+// a synthetic chain. Blocks, block IDs and validators are derived deterministically from the chain ID
+// and height, so two fakes of the same chain serve identical data. Latency, errors, the syncing flag,
+// the reported network and Cosmos SDK version and the pagination style can be changed at any time.
+// Transaction broadcasts of cosmos.tx.v1beta1.Service are served as well.
+type FakeUpstream struct {
+	tmservice.UnimplementedServiceServer
+
+	chainID string
+
+	mu               sync.Mutex
+	latestHeight     int64
+	validators       []*tmservice.Validator
+	validatorSets    map[int64][]*tmservice.Validator
+	txs              map[int64][][]byte
+	syncing          bool
+	network          string
+	cosmosSDKVersion string
+	latency          time.Duration
+	errs             map[string]error
+	nextKeys         bool
+	pageOverride     *query.PageResponse
+	blockFailures    map[int64]int
+	checkTxCode      uint32
+	omitSDKBlock     bool
+	calls            map[string]int
+	inFlight         int
+	maxInFlight      int
+}
+
+// NewFakeUpstream creates a fake upstream of chainID with blocks up to latestHeight and
+// numValidators synthetic validators of descending voting power.
+func NewFakeUpstream(chainID string, latestHeight int64, numValidators int) *FakeUpstream {
+	f := &FakeUpstream{
+		chainID:          chainID,
+		latestHeight:     latestHeight,
+		validatorSets:    make(map[int64][]*tmservice.Validator),
+		txs:              make(map[int64][][]byte),
+		network:          chainID,
+		cosmosSDKVersion: _fakeCosmosSDKVersion,
+		errs:             make(map[string]error),
+		blockFailures:    make(map[int64]int),
+		calls:            make(map[string]int),
+	}
+
+	for i := 0; i < numValidators; i++ {
+		f.validators = append(f.validators, fakeValidator(chainID, i, int64(numValidators-i)*1000))
+	}
+
+	return f
+}
+
+// ChainID returns the chain ID of the synthetic chain.
+func (f *FakeUpstream) ChainID() string {
+	return f.chainID
+}
+
+// AddBlocks advances the chain by n blocks.
+func (f *FakeUpstream) AddBlocks(n int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.latestHeight += n
+}
+
+// SetTxs sets the raw transactions of the block at height.
+func (f *FakeUpstream) SetTxs(height int64, txs ...[]byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.txs[height] = txs
+}
+
+// SetValidators replaces the validator set served for all heights without a set of their own.
+func (f *FakeUpstream) SetValidators(validators ...*tmservice.Validator) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.validators = validators
+}
+
+// SetValidatorsAt sets the validator set served for height only.
+func (f *FakeUpstream) SetValidatorsAt(height int64, validators ...*tmservice.Validator) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.validatorSets[height] = validators
+}
+
+// OmitSDKBlock makes block responses leave out sdk_block, like upstreams running a Cosmos SDK before v0.47.
+func (f *FakeUpstream) OmitSDKBlock(omit bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.omitSDKBlock = omit
+}
+
+// SetSyncing sets the flag returned by GetSyncing.
+func (f *FakeUpstream) SetSyncing(syncing bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.syncing = syncing
+}
+
+// SetNetwork overrides the network reported by GetNodeInfo, e.g. to simulate a misconfigured upstream.
+func (f *FakeUpstream) SetNetwork(network string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.network = network
+}
+
+// SetCosmosSDKVersion overrides the Cosmos SDK version reported by GetNodeInfo.
+func (f *FakeUpstream) SetCosmosSDKVersion(version string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cosmosSDKVersion = version
+}
+
+// SetLatency delays every call by d or until the call is cancelled.
+func (f *FakeUpstream) SetLatency(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.latency = d
+}
+
+// FailWith makes every call of method, e.g. "GetLatestBlock", fail with err. A nil err clears the failure.
+func (f *FakeUpstream) FailWith(method string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err == nil {
+		delete(f.errs, method)
+
+		return
+	}
+
+	f.errs[method] = err
+}
+
+// FailBlock makes the next n GetBlockByHeight calls for height fail with Unavailable.
+func (f *FakeUpstream) FailBlock(height int64, n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.blockFailures[height] = n
+}
+
+// UseNextKeys makes validator set pages return a next key instead of only the total,
+// like key based pagination does. By default the fake paginates by offset like CometBFT.
+func (f *FakeUpstream) UseNextKeys(nextKeys bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nextKeys = nextKeys
+}
+
+// OverridePagination makes validator set pages return page instead of the real pagination,
+// e.g. to simulate an upstream which repeats its next key. A nil page clears the override.
+func (f *FakeUpstream) OverridePagination(page *query.PageResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pageOverride = page
+}
+
+// Calls returns how many times method was called.
+func (f *FakeUpstream) Calls(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[method]
+}
+
+// MaxInFlight returns the highest number of calls served at the same time.
+func (f *FakeUpstream) MaxInFlight() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.maxInFlight
+}
+
+// Conn dials the fake like Dial with a new interface registry and closes the connection when
+// the test ends.
+func (f *FakeUpstream) Conn(tb testing.TB) *grpc.ClientConn {
+	tb.Helper()
+
+	conn, closer, err := f.Dial(context.Background(), registry.NewInterfaceRegistry(), nil)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	tb.Cleanup(closer)
+
+	return conn
+}
+
+// Dial serves the fake on a new bufconn listener and returns a client connection to it,
+// using the gogoproto codec of the Cosmos SDK on both ends. The returned function stops the server.
+func (f *FakeUpstream) Dial(
+	ctx context.Context,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	interceptors []grpc.UnaryClientInterceptor,
+) (*grpc.ClientConn, func(), error) {
+	grpcCodec := codec.NewProtoCodec(interfaceRegistry).GRPCCodec()
+
+	lis := bufconn.Listen(_bufSize)
+	grpcServer := grpc.NewServer(grpc.ForceServerCodec(grpcCodec), grpc.UnaryInterceptor(f.track))
+	tmservice.RegisterServiceServer(grpcServer, f)
+	txtypes.RegisterServiceServer(grpcServer, &fakeTxService{fake: f})
+
+	go grpcServer.Serve(lis) //nolint:errcheck
+
+	conn, err := client.NewGRPCConn(ctx, "", interceptors,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(getBufDialer(lis)),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	if err != nil {
+		grpcServer.Stop()
+
+		return nil, nil, err
+	}
+
+	//nolint:errcheck
+	closer := func() {
+		conn.Close()
+		grpcServer.Stop()
+	}
+
+	return conn, closer, nil
+}
+
+// GetNodeInfo implements tmservice.ServiceServer.
+func (f *FakeUpstream) GetNodeInfo(ctx context.Context, _ *tmservice.GetNodeInfoRequest) (*tmservice.GetNodeInfoResponse, error) {
+	if err := f.call(ctx, "GetNodeInfo"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return &tmservice.GetNodeInfoResponse{
+		DefaultNodeInfo: &cmtp2p.DefaultNodeInfo{
+			Network: f.network,
+			Moniker: "fake-" + f.chainID,
+			Version: "0.37.1",
+		},
+		ApplicationVersion: &tmservice.VersionInfo{
+			Name:             "fake",
+			AppName:          "faked",
+			Version:          "1.0.0",
+			CosmosSdkVersion: f.cosmosSDKVersion,
+		},
+	}, nil
+}
+
+// GetSyncing implements tmservice.ServiceServer.
+func (f *FakeUpstream) GetSyncing(ctx context.Context, _ *tmservice.GetSyncingRequest) (*tmservice.GetSyncingResponse, error) {
+	if err := f.call(ctx, "GetSyncing"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return &tmservice.GetSyncingResponse{Syncing: f.syncing}, nil
+}
+
+// GetLatestBlock implements tmservice.ServiceServer.
+func (f *FakeUpstream) GetLatestBlock(
+	ctx context.Context, _ *tmservice.GetLatestBlockRequest) (*tmservice.GetLatestBlockResponse, error) {
+	if err := f.call(ctx, "GetLatestBlock"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	blockID, block, sdkBlock := f.block(f.latestHeight)
+
+	return &tmservice.GetLatestBlockResponse{
+		BlockId:  blockID,
+		Block:    block,
+		SdkBlock: sdkBlock,
+	}, nil
+}
+
+// GetBlockByHeight implements tmservice.ServiceServer.
+func (f *FakeUpstream) GetBlockByHeight(
+	ctx context.Context, req *tmservice.GetBlockByHeightRequest) (*tmservice.GetBlockByHeightResponse, error) {
+	if err := f.call(ctx, "GetBlockByHeight"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.checkHeight(req.Height); err != nil {
+		return nil, err
+	}
+
+	if f.blockFailures[req.Height] > 0 {
+		f.blockFailures[req.Height]--
+
+		return nil, status.Errorf(codes.Unavailable, "block %d is unavailable", req.Height)
+	}
+
+	blockID, block, sdkBlock := f.block(req.Height)
+
+	return &tmservice.GetBlockByHeightResponse{
+		BlockId:  blockID,
+		Block:    block,
+		SdkBlock: sdkBlock,
+	}, nil
+}
+
+// GetLatestValidatorSet implements tmservice.ServiceServer.
+func (f *FakeUpstream) GetLatestValidatorSet(
+	ctx context.Context, req *tmservice.GetLatestValidatorSetRequest) (*tmservice.GetLatestValidatorSetResponse, error) {
+	if err := f.call(ctx, "GetLatestValidatorSet"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	validators, page, err := f.validatorPage(f.latestHeight, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmservice.GetLatestValidatorSetResponse{
+		BlockHeight: f.latestHeight,
+		Validators:  validators,
+		Pagination:  page,
+	}, nil
+}
+
+// GetValidatorSetByHeight implements tmservice.ServiceServer.
+func (f *FakeUpstream) GetValidatorSetByHeight(
+	ctx context.Context, req *tmservice.GetValidatorSetByHeightRequest) (*tmservice.GetValidatorSetByHeightResponse, error) {
+	if err := f.call(ctx, "GetValidatorSetByHeight"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.checkHeight(req.Height); err != nil {
+		return nil, err
+	}
+
+	validators, page, err := f.validatorPage(req.Height, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmservice.GetValidatorSetByHeightResponse{
+		BlockHeight: req.Height,
+		Validators:  validators,
+		Pagination:  page,
+	}, nil
+}
+
+// ABCIQuery implements tmservice.ServiceServer. It echoes the query data as the value at the requested
+// height, or at the latest height when none is given.
+func (f *FakeUpstream) ABCIQuery(ctx context.Context, req *tmservice.ABCIQueryRequest) (*tmservice.ABCIQueryResponse, error) {
+	if err := f.call(ctx, "ABCIQuery"); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	height := req.Height
+	if height == 0 {
+		height = f.latestHeight
+	}
+
+	return &tmservice.ABCIQueryResponse{
+		Key:    req.Data,
+		Value:  req.Data,
+		Height: height,
+	}, nil
+}
+
+// call records a call of method and applies the configured latency and error.
+func (f *FakeUpstream) call(ctx context.Context, method string) error {
+	f.mu.Lock()
+	f.calls[method]++
+	latency := f.latency
+	err := f.errs[method]
+	f.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+
+	return err
+}
+
+// track counts the calls in flight while handler serves one.
+func (f *FakeUpstream) track(
+	ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	f.mu.Lock()
+	f.inFlight++
+	if f.inFlight > f.maxInFlight {
+		f.maxInFlight = f.inFlight
+	}
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}()
+
+	return handler(ctx, req)
+}
+
+func (f *FakeUpstream) checkHeight(height int64) error {
+	if height <= 0 {
+		return status.Error(codes.InvalidArgument, "height must be greater than 0")
+	}
+
+	if height > f.latestHeight {
+		return status.Error(codes.InvalidArgument, "requested block height is bigger then the chain length")
+	}
+
+	return nil
+}
+
+func (f *FakeUpstream) block(height int64) (*cmtproto.BlockID, *cmtproto.Block, *tmservice.Block) {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", f.chainID, height)))
+	parentHash := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", f.chainID, height-1)))
+	blockTime := _fakeGenesisTime.Add(time.Duration(height-1) * _fakeBlockInterval)
+
+	blockID := &cmtproto.BlockID{
+		Hash:          hash[:],
+		PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: hash[:]},
+	}
+
+	lastBlockID := cmtproto.BlockID{}
+	if height > 1 {
+		lastBlockID = cmtproto.BlockID{
+			Hash:          parentHash[:],
+			PartSetHeader: cmtproto.PartSetHeader{Total: 1, Hash: parentHash[:]},
+		}
+	}
+
+	data := cmtproto.Data{Txs: f.txs[height]}
+
+	header := cmtproto.Header{
+		ChainID:     f.chainID,
+		Height:      height,
+		Time:        blockTime,
+		LastBlockId: lastBlockID,
+		AppHash:     hash[:],
+	}
+
+	var proposer string
+
+	if len(f.validators) > 0 {
+		proposer = f.validators[height%int64(len(f.validators))].Address
+		header.ProposerAddress = proposerAddress(proposer)
+	}
+
+	sdkBlock := &tmservice.Block{
+		Header: tmservice.Header{
+			ChainID:         header.ChainID,
+			Height:          header.Height,
+			Time:            header.Time,
+			LastBlockId:     header.LastBlockId,
+			AppHash:         header.AppHash,
+			ProposerAddress: proposer,
+		},
+		Data: data,
+	}
+
+	if f.omitSDKBlock {
+		sdkBlock = nil
+	}
+
+	return blockID, &cmtproto.Block{Header: header, Data: data}, sdkBlock
+}
+
+// validatorPage returns a page of the validator set at height. Offset pagination follows the Cosmos SDK,
+// which only reports the total. With next keys, the key holds the big-endian offset of the next page.
+func (f *FakeUpstream) validatorPage(
+	height int64, pageReq *query.PageRequest) ([]*tmservice.Validator, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	validators, ok := f.validatorSets[height]
+	if !ok {
+		validators = f.validators
+	}
+
+	offset := pageReq.Offset
+	if len(pageReq.Key) == 8 {
+		offset = binary.BigEndian.Uint64(pageReq.Key)
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	total := uint64(len(validators))
+	if offset > total {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "page should be within [1, %d] range", total/limit+1)
+	}
+
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+
+	page := &query.PageResponse{Total: total}
+	if f.nextKeys && end < total {
+		page.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	}
+
+	if f.pageOverride != nil {
+		page = &query.PageResponse{NextKey: f.pageOverride.NextKey, Total: f.pageOverride.Total}
+	}
+
+	return validators[offset:end], page, nil
+}
+
+func fakeValidator(chainID string, i int, power int64) *tmservice.Validator {
+	seed := sha256.Sum256([]byte(fmt.Sprintf("%s/validator/%d", chainID, i)))
+	pubKey := ed25519.GenPrivKeyFromSecret(seed[:]).PubKey()
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		panic(err)
+	}
+
+	return &tmservice.Validator{
+		Address:     sdk.ConsAddress(pubKey.Address()).String(),
+		PubKey:      pubKeyAny,
+		VotingPower: power,
+	}
+}
+
+func proposerAddress(bech32Addr string) []byte {
+	addr, err := sdk.ConsAddressFromBech32(bech32Addr)
+	if err != nil {
+		return nil
+	}
+
+	return addr
+}
@@ -0,0 +1,139 @@
+package testrunner_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+func newFakeUpstreamClient(t *testing.T, fakes ...*testrunner.FakeUpstream) pb.ServiceClient {
+	t.Helper()
+
+	logger := log.InitializeLogger("error", "json")
+	config := testrunner.NewDefaultTestConfig(logger, &configs.Config{}, jsonconv.NewJSONConverter())
+	config.Upstreams = fakes
+
+	conn, closer, err := testrunner.NewUnaryTestSetup(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(closer)
+
+	return pb.NewServiceClient(conn)
+}
+
+func TestFakeUpstreamChainState(t *testing.T) {
+	ctx := context.Background()
+	fake := testrunner.NewFakeUpstream("test-1", 100, 4)
+	fake.SetSyncing(true)
+	fake.SetTxs(100, []byte("tx"))
+
+	forwarderClient := newFakeUpstreamClient(t, fake)
+
+	latest, err := forwarderClient.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if latest.SdkBlock.Header.Height != 100 || len(latest.SdkBlock.Data.Txs) != 1 {
+		t.Errorf("unexpected latest block %+v", latest.SdkBlock.Header)
+	}
+
+	fake.AddBlocks(5)
+
+	if _, err := forwarderClient.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: 105}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = forwarderClient.GetBlockByHeight(ctx, &pb.GetBlockByHeightRequest{Height: 106})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument above the latest height, got %v", err)
+	}
+
+	syncing, err := forwarderClient.GetSyncing(ctx, &pb.GetSyncingRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !syncing.Syncing {
+		t.Error("expected the syncing flag of the fake")
+	}
+}
+
+func TestFakeUpstreamFaults(t *testing.T) {
+	ctx := context.Background()
+	fake := testrunner.NewFakeUpstream("test-1", 10, 1)
+	forwarderClient := newFakeUpstreamClient(t, fake)
+
+	fake.FailWith("GetSyncing", status.Error(codes.ResourceExhausted, "rate limited"))
+
+	if _, err := forwarderClient.GetSyncing(ctx, &pb.GetSyncingRequest{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected the injected error, got %v", err)
+	}
+
+	fake.FailWith("GetSyncing", nil)
+	fake.SetLatency(time.Second)
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := forwarderClient.GetSyncing(ctxWithTimeout, &pb.GetSyncingRequest{}); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestFakeUpstreamFailover(t *testing.T) {
+	ctx := context.Background()
+	healthy := testrunner.NewFakeUpstream("test-1", 10, 1)
+	misconfigured := testrunner.NewFakeUpstream("test-1", 10, 1)
+	misconfigured.SetNetwork("other-1")
+
+	forwarderClient := newFakeUpstreamClient(t, misconfigured, healthy)
+
+	for i := 0; i < 4; i++ {
+		if _, err := forwarderClient.GetLatestBlock(ctx, &pb.GetLatestBlockRequest{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := misconfigured.Calls("GetLatestBlock"); got != 0 {
+		t.Errorf("expected the quarantined upstream to get no calls, got %d", got)
+	}
+
+	if got := healthy.Calls("GetLatestBlock"); got != 4 {
+		t.Errorf("expected 4 calls on the healthy upstream, got %d", got)
+	}
+}
+
+func TestFakeUpstreamPagination(t *testing.T) {
+	for name, nextKeys := range map[string]bool{"offset": false, "next key": true} {
+		t.Run(name, func(t *testing.T) {
+			fake := testrunner.NewFakeUpstream("test-1", 10, 250)
+			fake.UseNextKeys(nextKeys)
+
+			resp, err := newFakeUpstreamClient(t, fake).GetFullValidatorSet(context.Background(),
+				&pb.GetFullValidatorSetRequest{PageSize: 100})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(resp.Validators) != 250 || resp.BlockHeight != 10 {
+				t.Errorf("expected 250 validators at height 10, got %d at %d", len(resp.Validators), resp.BlockHeight)
+			}
+
+			if got := fake.Calls("GetValidatorSetByHeight") + fake.Calls("GetLatestValidatorSet"); got != 3 {
+				t.Errorf("expected 3 pages, got %d", got)
+			}
+		})
+	}
+}
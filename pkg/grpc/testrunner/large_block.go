@@ -0,0 +1,62 @@
+package testrunner
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+)
+
+// LargeBlock returns a mainnet-sized GetBlockByHeight response of the fake chain for benchmarks:
+// numTxs synthetic transactions of txSize bytes and a last commit signed by every validator.
+// The transactions are not stored, so they are not served by the fake.
+func (f *FakeUpstream) LargeBlock(height int64, numTxs, txSize int) *tmservice.GetBlockByHeightResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	blockID, block, sdkBlock := f.block(height)
+
+	txs := make([][]byte, numTxs)
+	for i := range txs {
+		seed := sha256.Sum256([]byte(fmt.Sprintf("%s/%d/tx/%d", f.chainID, height, i)))
+
+		txs[i] = make([]byte, txSize)
+		for j := 0; j < txSize; j += len(seed) {
+			copy(txs[i][j:], seed[:])
+		}
+	}
+
+	signatures := make([]cmtproto.CommitSig, 0, len(f.validators))
+	for i, v := range f.validators {
+		signature := sha512.Sum512([]byte(fmt.Sprintf("%s/%d/signature/%d", f.chainID, height, i)))
+
+		signatures = append(signatures, cmtproto.CommitSig{
+			BlockIdFlag:      cmtproto.BlockIDFlagCommit,
+			ValidatorAddress: proposerAddress(v.Address),
+			Timestamp:        block.Header.Time,
+			Signature:        signature[:],
+		})
+	}
+
+	lastCommit := &cmtproto.Commit{
+		Height:     height - 1,
+		BlockID:    block.Header.LastBlockId,
+		Signatures: signatures,
+	}
+
+	block.Data.Txs = txs
+	block.LastCommit = lastCommit
+
+	if sdkBlock != nil {
+		sdkBlock.Data.Txs = txs
+		sdkBlock.LastCommit = lastCommit
+	}
+
+	return &tmservice.GetBlockByHeightResponse{
+		BlockId:  blockID,
+		Block:    block,
+		SdkBlock: sdkBlock,
+	}
+}
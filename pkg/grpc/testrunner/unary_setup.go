@@ -2,15 +2,21 @@ package testrunner
 
 import (
 	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"net"
 	"testing"
 
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/forwarder"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/server"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
 	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
@@ -28,10 +34,14 @@ type UnaryTestConfig struct {
 	Logger             log.Logger
 	Config             *configs.Config
 	JSONConverter      *jsonconv.JSONConverter
+	InterfaceRegistry  codectypes.InterfaceRegistry
 	ClientInterceptors []grpc.UnaryClientInterceptor
 	ServerInterceptors []grpc.UnaryServerInterceptor
 	ClientOptions      []grpc.DialOption
 	ServerOptions      []grpc.ServerOption
+	// Upstreams replace the upstream endpoints of Config when set. Fakes of the same chain
+	// form one pool in the given order.
+	Upstreams []*FakeUpstream
 }
 
 // HandleUnaryResponseError asserts gRPC error status codes.
@@ -50,14 +60,22 @@ func NewDefaultTestConfig(
 	logger log.Logger, config *configs.Config, jsonConverter *jsonconv.JSONConverter) UnaryTestConfig {
 	return UnaryTestConfig{
 		ServerInterceptors: []grpc.UnaryServerInterceptor{
-			server.NewLoggingInterceptor(logger, jsonConverter),
+			server.NewRequestIDInterceptor(logger),
+			server.NewLoggingInterceptor(logger, jsonConverter, logging.InitializePolicy(config, logger)),
 		},
 		ClientInterceptors: []grpc.UnaryClientInterceptor{
 			client.NewLoggingInterceptor(logger, jsonConverter),
 		},
-		Config:        config,
-		Logger:        logger,
-		JSONConverter: jsonConverter,
+		ServerOptions: []grpc.ServerOption{
+			grpc.ChainStreamInterceptor(
+				server.NewStreamRequestIDInterceptor(logger),
+				server.NewStreamLoggingInterceptor(logger, jsonConverter, logging.InitializePolicy(config, logger)),
+			),
+		},
+		Config:            config,
+		Logger:            logger,
+		JSONConverter:     jsonConverter,
+		InterfaceRegistry: registry.NewInterfaceRegistry(),
 	}
 }
 
@@ -77,14 +95,28 @@ func NewUnaryTestSetup(
 		config.ServerOptions...,
 	)
 
-	// TODO: This should be abstracted away in a gRPC service registration function.
-	forwarder.InitializeGRPCHandlers(
-		ctx,
-		config.Config.CosmosSDKGRPCEndpoint,
-		grpcServer,
-		config.Logger,
-		config.JSONConverter,
-	)
+	closeUpstreams := func() {}
+
+	if len(config.Upstreams) == 0 {
+		forwarder.InitializeGRPCHandlers(
+			ctx,
+			config.Config,
+			grpcServer,
+			config.Logger,
+			config.JSONConverter,
+			config.InterfaceRegistry,
+			nil,
+		)
+	} else {
+		router, closer, err := newFakeRouter(ctx, config)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		closeUpstreams = closer
+
+		forwarder.RegisterGRPCHandlers(ctx, config.Config, grpcServer, router, config.Logger, config.InterfaceRegistry)
+	}
 
 	errCh := make(chan error)
 
@@ -99,6 +131,7 @@ func NewUnaryTestSetup(
 	closer := func() {
 		lis.Close()
 		grpcServer.Shutdown(ctx)
+		closeUpstreams()
 	}
 
 	conn, err := client.NewGRPCConn(ctx, "", config.ClientInterceptors, config.ClientOptions...)
@@ -106,6 +139,62 @@ func NewUnaryTestSetup(
 	return conn, closer, err
 }
 
+// newFakeRouter connects to the fake upstreams over bufconn and routes to them like to real
+// upstreams, including one consistency check against COSMOS_SDK_VERSION_RANGE.
+func newFakeRouter(ctx context.Context, config UnaryTestConfig) (*upstream.Router, func(), error) {
+	versions, err := upstream.ParseVersionRange(config.Config.CosmosSDKVersionRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		chainIDs []string
+		closers  []func()
+	)
+
+	upstreams := make(map[string][]*upstream.Upstream)
+
+	closer := func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+
+	for i, fake := range config.Upstreams {
+		conn, closeFake, err := fake.Dial(ctx, config.InterfaceRegistry, []grpc.UnaryClientInterceptor{
+			client.NewRequestIDInterceptor(),
+			client.NewLoggingInterceptor(config.Logger, config.JSONConverter),
+		})
+		if err != nil {
+			closer()
+
+			return nil, nil, err
+		}
+
+		closers = append(closers, closeFake)
+
+		if _, ok := upstreams[fake.ChainID()]; !ok {
+			chainIDs = append(chainIDs, fake.ChainID())
+		}
+
+		upstreams[fake.ChainID()] = append(upstreams[fake.ChainID()], &upstream.Upstream{
+			Endpoint: fmt.Sprintf("fake-%d", i),
+			Conn:     conn,
+		})
+	}
+
+	pools := make([]*upstream.Pool, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		pools = append(pools, upstream.NewPool(chainID, upstreams[chainID]...))
+	}
+
+	router := upstream.NewRouter(config.Config.DefaultChainID, pools...)
+
+	upstream.NewGuard(router, versions, 0, config.Logger).Check(ctx)
+
+	return router, closer, nil
+}
+
 func getBufDialer(lis *bufconn.Listener) func(context.Context, string) (net.Conn, error) {
 	return func(ctx context.Context, url string) (net.Conn, error) {
 		return lis.Dial()
@@ -0,0 +1,197 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// Follower follows a chain through its upstream pool and writes every new block to the Store.
+type Follower struct {
+	store       *Store
+	router      *upstream.Router
+	startHeight int64
+	interval    time.Duration
+	logger      log.Logger
+}
+
+// NewFollower is a constructor function for Follower. On an empty index it starts from
+// startHeight, or from the latest block when startHeight is zero.
+func NewFollower(
+	store *Store,
+	router *upstream.Router,
+	startHeight int64,
+	interval time.Duration,
+	logger log.Logger,
+) *Follower {
+	return &Follower{
+		store:       store,
+		router:      router,
+		startHeight: startHeight,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// Run polls the upstream every interval and indexes the blocks produced since the last poll
+// until ctx is done. A zero interval syncs once and returns.
+func (f *Follower) Run(ctx context.Context) {
+	if f.interval <= 0 {
+		if err := f.Sync(ctx); err != nil {
+			f.logger.Warn("cannot sync index", log.String("chain_id", f.store.ChainID()), log.Error(err))
+		}
+
+		return
+	}
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.Sync(ctx); err != nil && ctx.Err() == nil {
+			f.logger.Warn("cannot sync index", log.String("chain_id", f.store.ChainID()), log.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync indexes all blocks between the last indexed height and the latest upstream height.
+func (f *Follower) Sync(ctx context.Context) error {
+	serviceClient, err := f.serviceClient()
+	if err != nil {
+		return err
+	}
+
+	latest, err := serviceClient.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return errors.Wrap(err, "cannot query latest block")
+	}
+
+	latestHeight := blockHeight(latest.GetSdkBlock(), latest.GetBlock())
+	if latestHeight <= 0 {
+		return errors.New("latest block has no height")
+	}
+
+	last, err := f.store.LastHeight()
+	if err != nil {
+		return err
+	}
+
+	from := last + 1
+
+	if last == 0 {
+		from = f.startHeight
+		if from <= 0 {
+			from = latestHeight
+		}
+	}
+
+	for height := from; height <= latestHeight; height++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		resp, err := serviceClient.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: height})
+		if err != nil {
+			return errors.Wrapf(err, "cannot query block %d", height)
+		}
+
+		if err := f.store.PutBlock(NewIndexedBlock(resp.GetBlockId(), resp.GetBlock(), resp.GetSdkBlock())); err != nil {
+			return errors.Wrapf(err, "cannot index block %d", height)
+		}
+
+		f.logger.Debug("indexed block", log.String("chain_id", f.store.ChainID()), log.Int64("height", height))
+	}
+
+	return nil
+}
+
+func (f *Follower) serviceClient() (tmservice.ServiceClient, error) {
+	pool, err := f.router.Pool(f.store.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pool.Conn()
+	if err != nil {
+		return nil, err
+	}
+
+	return tmservice.NewServiceClient(conn), nil
+}
+
+// NewIndexedBlock builds the indexed representation of a block: its ID, its header and the hashes of its txs.
+// They are taken from sdkBlock, or from block for upstreams running a Cosmos SDK before v0.47, which
+// don't send sdk_block.
+func NewIndexedBlock(blockID *cmtproto.BlockID, block *cmtproto.Block, sdkBlock *tmservice.Block) *pb.IndexedBlock {
+	indexed := &pb.IndexedBlock{
+		BlockId: blockID,
+	}
+
+	var txs [][]byte
+
+	switch {
+	case sdkBlock != nil:
+		header := sdkBlock.Header
+		indexed.Header = &header
+		txs = sdkBlock.Data.Txs
+	case block != nil:
+		header := sdkHeader(block.Header)
+		indexed.Header = &header
+		txs = block.Data.Txs
+	default:
+		return indexed
+	}
+
+	indexed.TxHashes = make([]string, 0, len(txs))
+
+	for _, tx := range txs {
+		indexed.TxHashes = append(indexed.TxHashes, TxHash(tx))
+	}
+
+	return indexed
+}
+
+// blockHeight returns the height of a block response from sdk_block, or from block for upstreams
+// running a Cosmos SDK before v0.47.
+func blockHeight(sdkBlock *tmservice.Block, block *cmtproto.Block) int64 {
+	if sdkBlock != nil {
+		return sdkBlock.Header.Height
+	}
+
+	return block.GetHeader().Height
+}
+
+// sdkHeader converts a CometBFT header to the header of sdk_block, like the Cosmos SDK does,
+// with the proposer address in bech32.
+func sdkHeader(h cmtproto.Header) tmservice.Header {
+	return tmservice.Header{
+		Version:            h.Version,
+		ChainID:            h.ChainID,
+		Height:             h.Height,
+		Time:               h.Time,
+		LastBlockId:        h.LastBlockId,
+		LastCommitHash:     h.LastCommitHash,
+		DataHash:           h.DataHash,
+		ValidatorsHash:     h.ValidatorsHash,
+		NextValidatorsHash: h.NextValidatorsHash,
+		ConsensusHash:      h.ConsensusHash,
+		AppHash:            h.AppHash,
+		LastResultsHash:    h.LastResultsHash,
+		EvidenceHash:       h.EvidenceHash,
+		ProposerAddress:    sdk.ConsAddress(h.ProposerAddress).String(),
+	}
+}
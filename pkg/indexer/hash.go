@@ -0,0 +1,28 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidHash is returned for block and tx hashes that are not hex encoded.
+var ErrInvalidHash = errors.New("invalid hash: expected hex encoding")
+
+// TxHash computes the CometBFT hash of raw transaction bytes as upper-case hex.
+func TxHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+}
+
+// decodeHash decodes a hex encoded block or tx hash. An optional 0x prefix is accepted.
+func decodeHash(hash string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X"))
+	if err != nil || len(b) == 0 {
+		return nil, errors.Wrapf(ErrInvalidHash, "%q", hash)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,162 @@
+package indexer_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/pkg/errors"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/indexer"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+func openTestStore(t *testing.T) *indexer.Store {
+	t.Helper()
+
+	store, err := indexer.OpenStore(filepath.Join(t.TempDir(), "index", "index.db"), "test-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { store.Close() })
+
+	return store
+}
+
+func TestFollowerSync(t *testing.T) {
+	ctx := context.Background()
+	fake := testrunner.NewFakeUpstream("test-1", 5, 4)
+
+	for height := int64(1); height <= 7; height++ {
+		fake.SetTxs(height, []byte(fmt.Sprintf("tx-%d", height)))
+	}
+
+	router := upstream.NewRouter("", upstream.NewPool("test-1", &upstream.Upstream{Endpoint: "fake", Conn: fake.Conn(t)}))
+	store := openTestStore(t)
+
+	follower := indexer.NewFollower(store, router, 3, 0, log.InitializeLogger("error", "json"))
+
+	if err := follower.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	fake.AddBlocks(2)
+
+	if err := follower.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	last, err := store.LastHeight()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if last != 7 {
+		t.Fatalf("expected last indexed height 7, got %d", last)
+	}
+
+	if _, err := store.BlockByHeight(2); !errors.Is(err, indexer.ErrNotFound) {
+		t.Errorf("expected blocks below the start height not to be indexed, got %v", err)
+	}
+
+	upstreamBlock, err := fake.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := store.BlockByHash(fmt.Sprintf("%x", upstreamBlock.BlockId.Hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if block.GetHeader().Height != 6 || block.GetHeader().ProposerAddress != upstreamBlock.SdkBlock.Header.ProposerAddress {
+		t.Errorf("unexpected block header %+v", block.GetHeader())
+	}
+
+	loc, err := store.TxByHash(indexer.TxHash([]byte("tx-4")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loc != (indexer.TxLocation{Height: 4, Index: 0}) {
+		t.Errorf("unexpected tx location %+v", loc)
+	}
+
+	if _, err := store.TxByHash("zz"); !errors.Is(err, indexer.ErrInvalidHash) {
+		t.Errorf("expected invalid hash error, got %v", err)
+	}
+}
+
+func TestFollowerSyncWithoutSDKBlock(t *testing.T) {
+	ctx := context.Background()
+	fake := testrunner.NewFakeUpstream("test-1", 5, 4)
+	fake.SetTxs(5, []byte("tx-5"))
+	fake.OmitSDKBlock(true)
+
+	router := upstream.NewRouter("", upstream.NewPool("test-1", &upstream.Upstream{Endpoint: "fake", Conn: fake.Conn(t)}))
+	store := openTestStore(t)
+
+	follower := indexer.NewFollower(store, router, 4, 0, log.InitializeLogger("error", "json"))
+
+	if err := follower.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	last, err := store.LastHeight()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if last != 5 {
+		t.Fatalf("expected last indexed height 5, got %d", last)
+	}
+
+	fake.OmitSDKBlock(false)
+
+	upstreamBlock, err := fake.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, err := store.BlockByHeight(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if block.GetHeader().ChainID != "test-1" ||
+		block.GetHeader().ProposerAddress != upstreamBlock.SdkBlock.Header.ProposerAddress {
+		t.Errorf("unexpected block header %+v", block.GetHeader())
+	}
+
+	if loc, err := store.TxByHash(indexer.TxHash([]byte("tx-5"))); err != nil || loc.Height != 5 {
+		t.Errorf("expected the tx of block 5 to be indexed, got %+v, %v", loc, err)
+	}
+}
+
+func TestStorePutBlockWithoutHeader(t *testing.T) {
+	store := openTestStore(t)
+
+	if err := store.PutBlock(indexer.NewIndexedBlock(nil, nil, nil)); err == nil {
+		t.Fatal("expected an error for a block without header")
+	}
+}
+
+func TestOpenStoreChainIDMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.db")
+
+	store, err := indexer.OpenStore(path, "test-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store.Close()
+
+	if _, err := indexer.OpenStore(path, "test-2"); err == nil {
+		t.Fatal("expected an error when reopening the index for another chain")
+	}
+}
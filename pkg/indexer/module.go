@@ -0,0 +1,39 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// InitializeIndexer opens the local index and starts following INDEXER_CHAIN_ID in the background.
+// It returns nil when the indexer is disabled. The store is closed once ctx is done.
+func InitializeIndexer(
+	ctx context.Context,
+	conf *configs.Config,
+	router *upstream.Router,
+	logger log.Logger,
+) *Store {
+	if !conf.IndexerEnabled {
+		return nil
+	}
+
+	store, err := OpenStore(conf.IndexerDBPath, conf.IndexerChainID)
+	if err != nil {
+		logger.Panic("error: cannot open local index: ", log.Error(err))
+	}
+
+	follower := NewFollower(store, router, conf.IndexerStartHeight, conf.IndexerPollInterval, logger)
+
+	go func() {
+		follower.Run(ctx)
+
+		if err := store.Close(); err != nil {
+			logger.Error("cannot close local index", log.Error(err))
+		}
+	}()
+
+	return store
+}
@@ -0,0 +1,250 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+)
+
+var (
+	// _bucketBlocks maps big-endian heights to IndexedBlock protos.
+	_bucketBlocks = []byte("blocks")
+	// _bucketBlockHashes maps block hashes to big-endian heights.
+	_bucketBlockHashes = []byte("block_hashes")
+	// _bucketTxHashes maps tx hashes to big-endian heights followed by the big-endian tx index.
+	_bucketTxHashes = []byte("tx_hashes")
+	// _bucketMeta holds the indexed chain ID and the last indexed height.
+	_bucketMeta = []byte("meta")
+
+	_keyChainID    = []byte("chain_id")
+	_keyLastHeight = []byte("last_height")
+)
+
+// ErrNotFound is returned when a block or a transaction is not in the index.
+var ErrNotFound = errors.New("not found in index")
+
+// TxLocation is the position of an indexed transaction.
+type TxLocation struct {
+	Height int64
+	Index  uint32
+}
+
+// Store is a bbolt backed index of block headers, block IDs and tx hashes of a single chain.
+type Store struct {
+	db      *bbolt.DB
+	chainID string
+}
+
+// OpenStore opens or creates the index database at path. An existing database
+// must have been created for the same chain ID.
+func OpenStore(path string, chainID string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot open index database %s", path)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, bucket := range [][]byte{_bucketBlocks, _bucketBlockHashes, _bucketTxHashes, _bucketMeta} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+
+		meta := tx.Bucket(_bucketMeta)
+
+		stored := meta.Get(_keyChainID)
+		if stored == nil {
+			return meta.Put(_keyChainID, []byte(chainID))
+		}
+
+		if string(stored) != chainID {
+			return errors.Errorf("index database %s belongs to chain %q, not %q", path, stored, chainID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		db.Close()
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &Store{
+		db:      db,
+		chainID: chainID,
+	}, nil
+}
+
+// Close closes the index database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// ChainID returns the chain ID the index belongs to.
+func (s *Store) ChainID() string {
+	return s.chainID
+}
+
+// LastHeight returns the highest indexed height or zero for an empty index.
+func (s *Store) LastHeight() (int64, error) {
+	var height int64
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(_bucketMeta).Get(_keyLastHeight); v != nil {
+			height = decodeHeight(v)
+		}
+
+		return nil
+	})
+
+	return height, errors.WithStack(err)
+}
+
+// PutBlock stores a block together with its hash and tx hash lookups in a single transaction.
+// Blocks without a header are rejected, since they can't be stored by height.
+func (s *Store) PutBlock(block *pb.IndexedBlock) error {
+	if block.GetHeader() == nil {
+		return errors.New("block has no header")
+	}
+
+	height := block.GetHeader().Height
+
+	value, err := proto.Marshal(block)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		heightKey := encodeHeight(height)
+
+		if err := tx.Bucket(_bucketBlocks).Put(heightKey, value); err != nil {
+			return err
+		}
+
+		if hash := block.GetBlockId().Hash; len(hash) > 0 {
+			if err := tx.Bucket(_bucketBlockHashes).Put(hash, heightKey); err != nil {
+				return err
+			}
+		}
+
+		txHashes := tx.Bucket(_bucketTxHashes)
+
+		for i, txHash := range block.TxHashes {
+			key, err := decodeHash(txHash)
+			if err != nil {
+				return err
+			}
+
+			if err := txHashes.Put(key, binary.BigEndian.AppendUint32(encodeHeight(height), uint32(i))); err != nil {
+				return err
+			}
+		}
+
+		meta := tx.Bucket(_bucketMeta)
+
+		if last := meta.Get(_keyLastHeight); last == nil || decodeHeight(last) < height {
+			return meta.Put(_keyLastHeight, heightKey)
+		}
+
+		return nil
+	})
+
+	return errors.WithStack(err)
+}
+
+// BlockByHeight returns the indexed block at the given height.
+func (s *Store) BlockByHeight(height int64) (*pb.IndexedBlock, error) {
+	var block *pb.IndexedBlock
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+
+		block, err = getBlock(tx, encodeHeight(height))
+
+		return err
+	})
+
+	return block, err
+}
+
+// BlockByHash returns the indexed block with the given hex encoded hash.
+func (s *Store) BlockByHash(hash string) (*pb.IndexedBlock, error) {
+	key, err := decodeHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var block *pb.IndexedBlock
+
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		heightKey := tx.Bucket(_bucketBlockHashes).Get(key)
+		if heightKey == nil {
+			return ErrNotFound
+		}
+
+		block, err = getBlock(tx, heightKey)
+
+		return err
+	})
+
+	return block, err
+}
+
+// TxByHash returns the location of the indexed transaction with the given hex encoded hash.
+func (s *Store) TxByHash(hash string) (TxLocation, error) {
+	key, err := decodeHash(hash)
+	if err != nil {
+		return TxLocation{}, err
+	}
+
+	var loc TxLocation
+
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(_bucketTxHashes).Get(key)
+		if len(v) != 12 {
+			return ErrNotFound
+		}
+
+		loc = TxLocation{
+			Height: decodeHeight(v[:8]),
+			Index:  binary.BigEndian.Uint32(v[8:]),
+		}
+
+		return nil
+	})
+
+	return loc, err
+}
+
+func getBlock(tx *bbolt.Tx, heightKey []byte) (*pb.IndexedBlock, error) {
+	v := tx.Bucket(_bucketBlocks).Get(heightKey)
+	if v == nil {
+		return nil, ErrNotFound
+	}
+
+	var block pb.IndexedBlock
+	if err := proto.Unmarshal(v, &block); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &block, nil
+}
+
+func encodeHeight(height int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(height))
+}
+
+func decodeHeight(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b))
+}
@@ -0,0 +1,81 @@
+package jsonconv_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+// FuzzMarshal renders arbitrary upstream responses in every JSON mode, with and without resolving
+// Any values, and decodes the output again. Errors are fine, panics are not.
+func FuzzMarshal(f *testing.F) {
+	seeds := []proto.Message{
+		&tmservice.GetNodeInfoResponse{},
+		&tmservice.GetBlockByHeightResponse{SdkBlock: &tmservice.Block{}},
+		&tmservice.GetValidatorSetByHeightResponse{
+			BlockHeight: 5,
+			Validators: []*tmservice.Validator{
+				{
+					Address: "cosmosvalcons1",
+					PubKey:  &codectypes.Any{TypeUrl: "/cosmos.crypto.ed25519.PubKey", Value: []byte{0xff, 0x01}},
+				},
+				{
+					Address: "cosmosvalcons2",
+					PubKey:  &codectypes.Any{TypeUrl: "/unknown.PubKey", Value: []byte("garbage")},
+				},
+			},
+		},
+		&tmservice.ABCIQueryResponse{ProofOps: &tmservice.ProofOps{Ops: []tmservice.ProofOp{{Type: "ics23:iavl"}}}},
+	}
+
+	for _, seed := range seeds {
+		b, err := proto.Marshal(seed)
+		if err != nil {
+			f.Fatal(err)
+		}
+
+		f.Add(b)
+	}
+
+	interfaceRegistry := registry.NewInterfaceRegistry()
+
+	var converters []*jsonconv.JSONConverter
+
+	for _, mode := range []jsonconv.Mode{
+		jsonconv.ModeDefault, jsonconv.ModeProto3, jsonconv.ModeAmino, jsonconv.ModeCanonical,
+	} {
+		converters = append(converters,
+			jsonconv.NewJSONConverter(jsonconv.WithMode(mode)),
+			jsonconv.NewJSONConverter(jsonconv.WithMode(mode), jsonconv.WithInterfaceRegistry(interfaceRegistry)),
+		)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, newMsg := range []func() proto.Message{
+			func() proto.Message { return &tmservice.GetNodeInfoResponse{} },
+			func() proto.Message { return &tmservice.GetSyncingResponse{} },
+			func() proto.Message { return &tmservice.GetBlockByHeightResponse{} },
+			func() proto.Message { return &tmservice.GetValidatorSetByHeightResponse{} },
+			func() proto.Message { return &tmservice.ABCIQueryResponse{} },
+		} {
+			msg := newMsg()
+			if proto.Unmarshal(data, msg) != nil {
+				continue
+			}
+
+			for _, converter := range converters {
+				out, err := converter.Marshal(msg)
+				if err != nil {
+					continue
+				}
+
+				_ = converter.Unmarshal(out, newMsg())
+			}
+		}
+	})
+}
@@ -3,22 +3,112 @@ package jsonconv
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/cosmos/gogoproto/proto"
 )
 
+// Mode is an enum representing JSON output modes.
+type Mode int
+
+const (
+	// ModeDefault is a setting for jsonpb marshaling with zero-valued settings.
+	ModeDefault Mode = iota
+	// ModeProto3 is a setting for proto3 JSON with default values emitted and original proto field names.
+	ModeProto3
+	// ModeAmino is a setting for Cosmos legacy Amino JSON.
+	ModeAmino
+	// ModeCanonical is a setting for compact proto3 JSON with sorted object keys.
+	// The output is stable, which makes it suitable for hashing and diffing.
+	ModeCanonical
+)
+
+// ParseMode parses to Mode a passed string value.
+func ParseMode(mode string) (Mode, error) {
+	switch strings.ToLower(mode) {
+	case "", "default":
+		return ModeDefault, nil
+	case "proto3":
+		return ModeProto3, nil
+	case "amino":
+		return ModeAmino, nil
+	case "canonical":
+		return ModeCanonical, nil
+	}
+
+	return ModeDefault, fmt.Errorf("not a valid JSON mode: %q", mode)
+}
+
 // JSONConverter is responsible for handling jsonpb marshalling and defaults to regular json marshaling
 // if the object is not of type proto.Message.
 type JSONConverter struct {
-	Marshaler *jsonpb.Marshaler
+	Mode        Mode
+	Marshaler   *jsonpb.Marshaler
+	Unmarshaler *jsonpb.Unmarshaler
+	Amino       *codec.LegacyAmino
+	// InterfaceRegistry resolves google.protobuf.Any values so that they are expanded
+	// to their JSON representation instead of opaque base64.
+	InterfaceRegistry codectypes.InterfaceRegistry
+}
+
+// Option represents JSON converter configuration options.
+type Option interface {
+	apply(*JSONConverter)
+}
+
+type optionFunc func(*JSONConverter)
+
+func (f optionFunc) apply(j *JSONConverter) {
+	f(j)
+}
+
+// WithMode sets the JSON output mode.
+func WithMode(mode Mode) Option {
+	return optionFunc(func(j *JSONConverter) {
+		j.Mode = mode
+	})
+}
+
+// WithInterfaceRegistry sets the registry used for resolving google.protobuf.Any values.
+func WithInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) Option {
+	return optionFunc(func(j *JSONConverter) {
+		j.InterfaceRegistry = interfaceRegistry
+	})
 }
 
 // NewJSONConverter is a constructor function for JSONConverter.
-func NewJSONConverter() *JSONConverter {
-	return &JSONConverter{
-		Marshaler: &jsonpb.Marshaler{},
+func NewJSONConverter(opt ...Option) *JSONConverter {
+	j := &JSONConverter{
+		Mode:  ModeDefault,
+		Amino: codec.NewLegacyAmino(),
+	}
+
+	for _, o := range opt {
+		o.apply(j)
+	}
+
+	switch j.Mode {
+	case ModeProto3, ModeCanonical:
+		j.Marshaler = &jsonpb.Marshaler{
+			EmitDefaults: true,
+			OrigName:     true,
+		}
+	default:
+		j.Marshaler = &jsonpb.Marshaler{}
 	}
+
+	j.Unmarshaler = &jsonpb.Unmarshaler{}
+
+	if j.InterfaceRegistry != nil {
+		j.Marshaler.AnyResolver = j.InterfaceRegistry
+		j.Unmarshaler.AnyResolver = j.InterfaceRegistry
+	}
+
+	return j
 }
 
 // Marshal handles jsonpb marshalling when necessary and defaults to regular json marshaling otherwise.
@@ -30,14 +120,60 @@ func (j *JSONConverter) Marshal(obj any) ([]byte, error) {
 	)
 
 	protoReq, ok := obj.(proto.Message)
-	if ok {
+
+	switch {
+	case ok && j.Mode == ModeAmino:
+		jsonBytes, err = j.Amino.MarshalJSON(protoReq)
+	case ok:
 		err = j.Marshaler.Marshal(&buf, protoReq)
 		if err == nil {
 			jsonBytes = buf.Bytes()
 		}
-	} else {
+	default:
 		jsonBytes, err = json.Marshal(obj)
 	}
 
+	if err == nil && j.Mode == ModeCanonical {
+		jsonBytes, err = SortJSON(jsonBytes)
+	}
+
 	return jsonBytes, err
 }
+
+// Unmarshal decodes JSON produced in the converter's mode into obj. It handles jsonpb unmarshalling
+// when necessary and defaults to regular json unmarshalling otherwise.
+func (j *JSONConverter) Unmarshal(data []byte, obj any) error {
+	protoReq, ok := obj.(proto.Message)
+
+	switch {
+	case ok && j.Mode == ModeAmino:
+		return j.Amino.UnmarshalJSON(data, protoReq)
+	case ok:
+		return j.Unmarshaler.Unmarshal(bytes.NewReader(data), protoReq)
+	default:
+		return json.Unmarshal(data, obj)
+	}
+}
+
+// SortJSON re-encodes a JSON document in compact form with all object keys sorted.
+// Numbers are kept verbatim to avoid any loss of precision.
+func SortJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
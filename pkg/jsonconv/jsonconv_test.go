@@ -0,0 +1,119 @@
+package jsonconv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/proto/tendermint/p2p"
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+)
+
+func TestJSONConverterModes(t *testing.T) {
+	msg := &pb.GetNodeInfoResponse{
+		DefaultNodeInfo: &p2p.DefaultNodeInfo{
+			Network: "osmosis-1",
+			Moniker: "node",
+		},
+		ApplicationVersion: &pb.VersionInfo{
+			AppName:          "osmosisd",
+			CosmosSdkVersion: "v0.47.2",
+		},
+	}
+
+	for _, tc := range []struct {
+		mode jsonconv.Mode
+		want string
+	}{
+		{
+			mode: jsonconv.ModeDefault,
+			want: `{"defaultNodeInfo":{"protocolVersion":{},"network":"osmosis-1","moniker":"node","other":{}},` +
+				`"applicationVersion":{"appName":"osmosisd","cosmosSdkVersion":"v0.47.2"}}`,
+		},
+		{
+			mode: jsonconv.ModeCanonical,
+			want: `{"application_version":{"app_name":"osmosisd","build_deps":[],"build_tags":"",` +
+				`"cosmos_sdk_version":"v0.47.2","git_commit":"","go_version":"","name":"","version":""},` +
+				`"default_node_info":{"channels":null,"default_node_id":"","listen_addr":"","moniker":"node",` +
+				`"network":"osmosis-1","other":{"rpc_address":"","tx_index":""},` +
+				`"protocol_version":{"app":"0","block":"0","p2p":"0"},"version":""}}`,
+		},
+		{
+			mode: jsonconv.ModeAmino,
+			want: `{"default_node_info":{"protocol_version":{},` +
+				`"network":"osmosis-1","moniker":"node","other":{}},` +
+				`"application_version":{"app_name":"osmosisd","cosmos_sdk_version":"v0.47.2"}}`,
+		},
+	} {
+		converter := jsonconv.NewJSONConverter(jsonconv.WithMode(tc.mode))
+
+		got, err := converter.Marshal(msg)
+		if err != nil {
+			t.Fatalf("mode %d: %v", tc.mode, err)
+		}
+
+		if string(got) != tc.want {
+			t.Errorf("mode %d:\nexpected %s\ngot      %s", tc.mode, tc.want, got)
+		}
+
+		var decoded pb.GetNodeInfoResponse
+		if err := converter.Unmarshal(got, &decoded); err != nil {
+			t.Fatalf("mode %d: %v", tc.mode, err)
+		}
+
+		if decoded.DefaultNodeInfo.GetNetwork() != "osmosis-1" ||
+			decoded.ApplicationVersion.GetCosmosSdkVersion() != "v0.47.2" {
+			t.Errorf("mode %d: unexpected round trip result %v", tc.mode, &decoded)
+		}
+	}
+}
+
+func TestJSONConverterProto3(t *testing.T) {
+	converter := jsonconv.NewJSONConverter(jsonconv.WithMode(jsonconv.ModeProto3))
+
+	got, err := converter.Marshal(&pb.GetBlockByHeightRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"height":"0"}`; string(got) != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestJSONConverterNonProto(t *testing.T) {
+	converter := jsonconv.NewJSONConverter(jsonconv.WithMode(jsonconv.ModeCanonical))
+
+	got, err := converter.Marshal(map[string]any{"b": 1, "a": time.Duration(0), "c": "<&>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"a":0,"b":1,"c":"<&>"}`; string(got) != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	var decoded map[string]int
+	if err := converter.Unmarshal([]byte(`{"a":1}`), &decoded); err != nil || decoded["a"] != 1 {
+		t.Errorf("unexpected unmarshal result %v: %v", decoded, err)
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	for input, want := range map[string]jsonconv.Mode{
+		"":          jsonconv.ModeDefault,
+		"default":   jsonconv.ModeDefault,
+		"proto3":    jsonconv.ModeProto3,
+		"Amino":     jsonconv.ModeAmino,
+		"canonical": jsonconv.ModeCanonical,
+	} {
+		got, err := jsonconv.ParseMode(input)
+		if err != nil || got != want {
+			t.Errorf("expected %d for %q, got %d: %v", want, input, got, err)
+		}
+	}
+
+	if _, err := jsonconv.ParseMode("yaml"); err == nil {
+		t.Error("expected an error for an unknown mode")
+	}
+}
@@ -0,0 +1,19 @@
+package jsonconv
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// InitializeJSONConverter configures a JSON converter for the given JSON mode which resolves
+// google.protobuf.Any values through the interface registry.
+func InitializeJSONConverter(jsonMode string, interfaceRegistry codectypes.InterfaceRegistry) *JSONConverter {
+	mode, err := ParseMode(jsonMode)
+	if err != nil {
+		panic(err)
+	}
+
+	return NewJSONConverter(
+		WithMode(mode),
+		WithInterfaceRegistry(interfaceRegistry),
+	)
+}
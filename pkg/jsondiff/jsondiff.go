@@ -0,0 +1,145 @@
+// Package jsondiff compares JSON documents canonically, ignoring key order and number formatting.
+package jsondiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Difference is a value which differs between two documents. A missing value is nil.
+type Difference struct {
+	// Path is the dot-separated location of the value, with array indices in brackets, e.g. validators[3].voting_power.
+	Path  string `json:"path"`
+	Left  any    `json:"left"`
+	Right any    `json:"right"`
+}
+
+// String formats the difference for logs.
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %s != %s", d.Path, render(d.Left), render(d.Right))
+}
+
+// Compare returns the differences between the JSON documents left and right ordered by path.
+// Objects are compared by key regardless of key order and numbers by value. A null value equals
+// a missing one, since proto JSON encoders differ in whether they emit unset fields.
+func Compare(left, right []byte) ([]Difference, error) {
+	l, err := decode(left)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid left document")
+	}
+
+	r, err := decode(right)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid right document")
+	}
+
+	var diffs []Difference
+
+	compare("", l, r, &diffs)
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+
+	return diffs, nil
+}
+
+func decode(doc []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func compare(path string, left, right any, diffs *[]Difference) {
+	switch l := left.(type) {
+	case map[string]any:
+		r, ok := right.(map[string]any)
+		if !ok {
+			break
+		}
+
+		for key, lv := range l {
+			compare(join(path, key), lv, r[key], diffs)
+		}
+
+		for key, rv := range r {
+			if _, ok := l[key]; !ok {
+				compare(join(path, key), nil, rv, diffs)
+			}
+		}
+
+		return
+	case []any:
+		r, ok := right.([]any)
+		if !ok {
+			break
+		}
+
+		for i := 0; i < len(l) || i < len(r); i++ {
+			var lv, rv any
+
+			if i < len(l) {
+				lv = l[i]
+			}
+
+			if i < len(r) {
+				rv = r[i]
+			}
+
+			compare(path+"["+strconv.Itoa(i)+"]", lv, rv, diffs)
+		}
+
+		return
+	case json.Number:
+		if r, ok := right.(json.Number); ok && equalNumbers(l, r) {
+			return
+		}
+	default:
+		if left == right {
+			return
+		}
+	}
+
+	*diffs = append(*diffs, Difference{Path: path, Left: left, Right: right})
+}
+
+func equalNumbers(l, r json.Number) bool {
+	if l == r {
+		return true
+	}
+
+	lf, errL := l.Float64()
+	rf, errR := r.Float64()
+
+	return errL == nil && errR == nil && lf == rf
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func render(v any) string {
+	if v == nil {
+		return "<missing>"
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(b)
+}
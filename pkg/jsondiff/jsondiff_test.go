@@ -0,0 +1,39 @@
+package jsondiff_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsondiff"
+)
+
+func TestCompare(t *testing.T) {
+	left := `{"height":"10","block":{"txs":["a","b"],"time":"t1"},"n":1.0,"extra":null}`
+	right := `{"block":{"time":"t2","txs":["a"]},"n":1,"height":"10","added":true}`
+
+	diffs, err := jsondiff.Compare([]byte(left), []byte(right))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`added: <missing> != true`,
+		`block.time: "t1" != "t2"`,
+		`block.txs[1]: "b" != <missing>`,
+	}
+
+	if fmt.Sprint(diffs) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, diffs)
+	}
+}
+
+func TestCompareEqual(t *testing.T) {
+	diffs, err := jsondiff.Compare([]byte(`{"a":[1,{"b":2}],"c":"d"}`), []byte(`{"c":"d","a":[1.0,{"b":2e0}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diffs) != 0 {
+		t.Errorf("expected no differences, got %v", diffs)
+	}
+}
@@ -0,0 +1,19 @@
+package log
+
+import "context"
+
+type loggerContextKey struct{}
+
+// NewContext returns a copy of ctx which carries the logger.
+func NewContext(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// FromContext returns the logger stored in ctx or fallback if ctx doesn't carry one.
+func FromContext(ctx context.Context, fallback Logger) Logger {
+	if logger, ok := ctx.Value(loggerContextKey{}).(Logger); ok {
+		return logger
+	}
+
+	return fallback
+}
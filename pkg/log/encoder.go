@@ -0,0 +1,103 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+var _bufferPool = buffer.NewPool()
+
+// jsonField is a single top-level field of a JSON encoded log statement.
+type jsonField struct {
+	Key   string
+	Value json.RawMessage
+}
+
+// jsonFieldsEncoder is a base for encoders which re-render statements encoded by the zap JSON encoder.
+// Going through JSON keeps the full zapcore.ObjectEncoder implementation of zap, including
+// namespaces and nested objects, while the wrapping encoder only cares about presentation.
+type jsonFieldsEncoder struct {
+	zapcore.Encoder
+	render func(out *buffer.Buffer, fields []jsonField) error
+}
+
+// EncodeEntry implements zapcore.Encoder.
+func (e *jsonFieldsEncoder) EncodeEntry(ent zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	jsonBuf, err := e.Encoder.EncodeEntry(ent, fields)
+	if err != nil {
+		return nil, err
+	}
+	defer jsonBuf.Free()
+
+	parsed, err := parseJSONFields(jsonBuf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	out := _bufferPool.Get()
+
+	if err := e.render(out, parsed); err != nil {
+		out.Free()
+
+		return nil, err
+	}
+
+	out.AppendString(zapcore.DefaultLineEnding)
+
+	return out, nil
+}
+
+// parseJSONFields splits a JSON object into its top-level fields keeping their order.
+func parseJSONFields(data []byte) ([]jsonField, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expected a JSON object, got %v", tok)
+	}
+
+	fields := make([]jsonField, 0, 8)
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a JSON object key, got %v", tok)
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, jsonField{Key: key, Value: value})
+	}
+
+	return fields, nil
+}
+
+// rawString returns the unquoted value of a JSON string and true, or false if the value is not a string.
+func rawString(value json.RawMessage) (string, bool) {
+	if len(value) == 0 || value[0] != '"' {
+		return "", false
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", false
+	}
+
+	return s, true
+}
@@ -0,0 +1,104 @@
+package log
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+// newLogfmtEncoder creates an encoder which writes statements as logfmt key=value pairs.
+// Nested objects are flattened into dot-separated keys and arrays are written as quoted JSON.
+func newLogfmtEncoder(cfg zapcore.EncoderConfig) zapcore.Encoder {
+	return &logfmtEncoder{
+		jsonFieldsEncoder: jsonFieldsEncoder{
+			Encoder: zapcore.NewJSONEncoder(cfg),
+			render:  renderLogfmt,
+		},
+	}
+}
+
+type logfmtEncoder struct {
+	jsonFieldsEncoder
+}
+
+// Clone implements zapcore.Encoder.
+func (e *logfmtEncoder) Clone() zapcore.Encoder {
+	return &logfmtEncoder{
+		jsonFieldsEncoder: jsonFieldsEncoder{
+			Encoder: e.Encoder.Clone(),
+			render:  e.render,
+		},
+	}
+}
+
+func renderLogfmt(out *buffer.Buffer, fields []jsonField) error {
+	for _, f := range fields {
+		if err := appendLogfmtField(out, f.Key, f.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func appendLogfmtField(out *buffer.Buffer, key string, value json.RawMessage) error {
+	if len(value) > 0 && value[0] == '{' {
+		nested, err := parseJSONFields(value)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range nested {
+			if err := appendLogfmtField(out, key+"."+f.Key, f.Value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if out.Len() > 0 {
+		out.AppendByte(' ')
+	}
+
+	out.AppendString(logfmtKey(key))
+	out.AppendByte('=')
+
+	if s, ok := rawString(value); ok {
+		out.AppendString(logfmtValue(s))
+	} else {
+		out.AppendString(logfmtValue(string(value)))
+	}
+
+	return nil
+}
+
+func logfmtKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == '=' || r == '"' || r == unicode.ReplacementChar {
+			return '_'
+		}
+
+		return r
+	}, key)
+}
+
+func logfmtValue(value string) string {
+	if value == "" {
+		return `""`
+	}
+
+	needsQuoting := strings.IndexFunc(value, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == '\\' || !unicode.IsPrint(r)
+	}) >= 0
+
+	if needsQuoting {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
@@ -0,0 +1,166 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	_colorReset   = "\x1b[0m"
+	_colorRed     = "\x1b[31m"
+	_colorGreen   = "\x1b[32m"
+	_colorYellow  = "\x1b[33m"
+	_colorBlue    = "\x1b[34m"
+	_colorMagenta = "\x1b[35m"
+	_colorGray    = "\x1b[90m"
+	_colorBold    = "\x1b[1m"
+
+	_prettyIndent = "    "
+)
+
+// newPrettyEncoder creates an encoder which writes colored, human-friendly statements.
+// The statement header holds the time, level, logger name and message, followed by
+// one line per field. String fields holding JSON documents are pretty-printed.
+func newPrettyEncoder(cfg zapcore.EncoderConfig) zapcore.Encoder {
+	enc := &prettyEncoder{cfg: cfg}
+	enc.jsonFieldsEncoder = jsonFieldsEncoder{
+		Encoder: zapcore.NewJSONEncoder(cfg),
+		render:  enc.render,
+	}
+
+	return enc
+}
+
+type prettyEncoder struct {
+	jsonFieldsEncoder
+	cfg zapcore.EncoderConfig
+}
+
+// Clone implements zapcore.Encoder.
+func (e *prettyEncoder) Clone() zapcore.Encoder {
+	c := &prettyEncoder{cfg: e.cfg}
+	c.jsonFieldsEncoder = jsonFieldsEncoder{
+		Encoder: e.Encoder.Clone(),
+		render:  c.render,
+	}
+
+	return c
+}
+
+func (e *prettyEncoder) render(out *buffer.Buffer, fields []jsonField) error {
+	var timeStr, levelStr, nameStr, msgStr, callerStr, stackStr string
+
+	rest := make([]jsonField, 0, len(fields))
+
+	for _, f := range fields {
+		s, _ := rawString(f.Value)
+
+		switch f.Key {
+		case e.cfg.TimeKey:
+			timeStr = s
+		case e.cfg.LevelKey:
+			levelStr = s
+		case e.cfg.NameKey:
+			nameStr = s
+		case e.cfg.MessageKey:
+			msgStr = s
+		case e.cfg.CallerKey:
+			callerStr = s
+		case e.cfg.StacktraceKey:
+			stackStr = s
+		default:
+			rest = append(rest, f)
+		}
+	}
+
+	if timeStr != "" {
+		out.AppendString(_colorGray + timeStr + _colorReset + " ")
+	}
+
+	level := strings.ToUpper(levelStr)
+	out.AppendString(levelColor(level) + padRight(level, 6) + _colorReset)
+
+	if nameStr != "" {
+		out.AppendString(_colorBlue + nameStr + _colorReset + " ")
+	}
+
+	out.AppendString(_colorBold + msgStr + _colorReset)
+
+	if callerStr != "" {
+		out.AppendString(" " + _colorGray + callerStr + _colorReset)
+	}
+
+	for _, f := range rest {
+		out.AppendString("\n" + _prettyIndent + _colorGreen + f.Key + _colorReset + ": ")
+		out.AppendString(prettyValue(f.Value))
+	}
+
+	if stackStr != "" {
+		out.AppendString("\n" + _colorGray + stackStr + _colorReset)
+	}
+
+	return nil
+}
+
+// prettyValue renders a field value. Objects, arrays and strings holding JSON documents
+// are indented under the field key.
+func prettyValue(value json.RawMessage) string {
+	doc := []byte(value)
+
+	if s, ok := rawString(value); ok {
+		trimmed := strings.TrimSpace(s)
+		if !isJSONDocument(trimmed) {
+			return s
+		}
+
+		doc = []byte(trimmed)
+	}
+
+	if !isJSONDocument(string(doc)) {
+		return string(doc)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, doc, _prettyIndent, "  "); err != nil {
+		return string(doc)
+	}
+
+	return indented.String()
+}
+
+func isJSONDocument(s string) bool {
+	if len(s) < 2 {
+		return false
+	}
+
+	if (s[0] != '{' || s[len(s)-1] != '}') && (s[0] != '[' || s[len(s)-1] != ']') {
+		return false
+	}
+
+	return json.Valid([]byte(s))
+}
+
+func levelColor(level string) string {
+	switch level {
+	case "DEBUG":
+		return _colorMagenta
+	case "INFO":
+		return _colorBlue
+	case "WARN":
+		return _colorYellow
+	default:
+		return _colorRed
+	}
+}
+
+func padRight(s string, n int) string {
+	if len(s) >= n {
+		return s + " "
+	}
+
+	return s + strings.Repeat(" ", n-len(s))
+}
@@ -13,6 +13,11 @@ const (
 	FormatConsole Format = iota
 	// FormatJSON is a setting for json formatted statements.
 	FormatJSON
+	// FormatLogfmt is a setting for logfmt formatted statements.
+	FormatLogfmt
+	// FormatPretty is a setting for colored, multi-line statements meant for local development.
+	// Fields holding JSON documents, like logged requests and responses, are expanded.
+	FormatPretty
 )
 
 // ParseFormat parses to Format a passed string value.
@@ -22,6 +27,10 @@ func ParseFormat(format string) (Format, error) {
 		return FormatConsole, nil
 	case "json":
 		return FormatJSON, nil
+	case "logfmt":
+		return FormatLogfmt, nil
+	case "pretty":
+		return FormatPretty, nil
 	}
 
 	return FormatConsole, fmt.Errorf("not a valid log format: %q", format)
@@ -0,0 +1,100 @@
+package log_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+func TestParseFormat(t *testing.T) {
+	for input, want := range map[string]log.Format{
+		"console": log.FormatConsole,
+		"JSON":    log.FormatJSON,
+		"logfmt":  log.FormatLogfmt,
+		"pretty":  log.FormatPretty,
+	} {
+		got, err := log.ParseFormat(input)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", input, err)
+		}
+
+		if got != want {
+			t.Errorf("expected %d for %q, got %d", want, input, got)
+		}
+	}
+
+	if _, err := log.ParseFormat("yaml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestLogfmtFormat(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(
+		log.WithFormat(log.FormatLogfmt),
+		log.WithLogToStdout(false),
+		log.WithOutput(&buf),
+	).Named("grpc.server").With(log.String("request_id", "abc"))
+
+	logger.Info("gRPC request",
+		log.String("method", "/api.cosmos.forwarder.v1.Service/GetSyncing"),
+		log.String("response", `{"syncing":false}`),
+		log.Int("code", 0),
+		log.String("empty", ""),
+		log.Namespace("upstream"),
+		log.String("endpoint", "grpc.osmosis.zone:9090"),
+	)
+
+	out := buf.String()
+
+	for _, want := range []string{
+		`level=info `,
+		`logger=grpc.server `,
+		`msg="gRPC request" `,
+		`request_id=abc `,
+		`method=/api.cosmos.forwarder.v1.Service/GetSyncing `,
+		`response="{\"syncing\":false}" `,
+		`code=0 `,
+		`empty="" `,
+		`upstream.endpoint=grpc.osmosis.zone:9090` + "\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in logfmt output %q", want, out)
+		}
+	}
+
+	if strings.Count(out, "\n") != 1 {
+		t.Errorf("expected a single line, got %q", out)
+	}
+}
+
+func TestPrettyFormat(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(
+		log.WithFormat(log.FormatPretty),
+		log.WithLogToStdout(false),
+		log.WithOutput(&buf),
+	)
+
+	logger.Warn("gRPC request",
+		log.String("method", "/api.cosmos.forwarder.v1.Service/GetSyncing"),
+		log.String("response", `{"syncing":false}`),
+	)
+
+	out := buf.String()
+
+	for _, want := range []string{
+		"WARN",
+		"gRPC request",
+		"method\x1b[0m: /api.cosmos.forwarder.v1.Service/GetSyncing\n",
+		"response\x1b[0m: {\n" + `      "syncing": false` + "\n    }\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in pretty output %q", want, out)
+		}
+	}
+}
@@ -32,6 +32,28 @@ func (l Level) Enabled(lvl Level) bool {
 	return lvl >= l
 }
 
+// String returns a lower-case representation of a log level.
+func (l Level) String() string {
+	switch l {
+	case DebugLevel:
+		return "debug"
+	case InfoLevel:
+		return "info"
+	case WarnLevel:
+		return "warn"
+	case ErrorLevel:
+		return "error"
+	case DPanicLevel:
+		return "dpanic"
+	case PanicLevel:
+		return "panic"
+	case FatalLevel:
+		return "fatal"
+	}
+
+	return fmt.Sprintf("Level(%d)", l)
+}
+
 // ParseLevel parses a log level from a string value.
 func ParseLevel(lvl string) (Level, error) {
 	switch strings.ToLower(lvl) {
@@ -75,7 +97,6 @@ func fromZapLevel(lvl zapcore.Level) Level {
 	return InfoLevel
 }
 
-//nolint:unused
 func toZapLevel(lvl Level) zapcore.Level {
 	switch lvl {
 	case DebugLevel:
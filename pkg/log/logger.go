@@ -17,6 +17,10 @@ type Logger interface {
 	DPanic(msg string, args ...Field)
 	Panic(msg string, args ...Field)
 	Fatal(msg string, args ...Field)
+	Named(name string) Logger
+	With(fields ...Field) Logger
+	Enabled(lvl Level) bool
+	PrintEnabled() bool
 }
 
 // Field is a type alias for zap.Field.
@@ -50,6 +54,7 @@ var (
 	Int8p       = zap.Int8p
 	String      = zap.String
 	Stringp     = zap.Stringp
+	Strings     = zap.Strings
 	Uint        = zap.Uint
 	Uintp       = zap.Uintp
 	Uint64      = zap.Uint64
@@ -80,6 +85,9 @@ type logFunc func(logger *zap.Logger, msg string, fields ...Field)
 type StructuredLogger struct {
 	base    *zap.Logger
 	options options
+	levels  *levelRegistry
+
+	printLevel Level
 
 	print  logFunc
 	debug  logFunc
@@ -91,7 +99,10 @@ type StructuredLogger struct {
 	fatal  logFunc
 }
 
-var _ Logger = (*StructuredLogger)(nil)
+var (
+	_ Logger          = (*StructuredLogger)(nil)
+	_ LevelController = (*StructuredLogger)(nil)
+)
 
 var _defaultOptions = options{
 	Development: false,
@@ -119,26 +130,16 @@ func newLogger(opts options) *StructuredLogger {
 	if opts.Encoder != nil {
 		encoder = opts.Encoder
 	} else {
-		var encoderCfg zapcore.EncoderConfig
-
-		if opts.Development {
-			encoderCfg = zap.NewDevelopmentEncoderConfig()
-			encoderCfg.EncodeTime = zapcore.RFC3339TimeEncoder
-			encoderCfg.EncodeCaller = zapcore.FullCallerEncoder
-		} else {
-			encoderCfg = zap.NewProductionEncoderConfig()
-			encoderCfg.TimeKey = "time"
-			encoderCfg.EncodeTime = zapcore.RFC3339TimeEncoder
-		}
-
-		switch opts.Format {
-		case FormatJSON:
-			encoder = zapcore.NewJSONEncoder(encoderCfg)
-		default:
-			encoder = zapcore.NewConsoleEncoder(encoderCfg)
-		}
+		encoder = newEncoder(opts.Format, opts.Development)
+	}
+
+	rootLevel := opts.Level
+	if opts.Development {
+		rootLevel = DebugLevel
 	}
 
+	levels := newLevelRegistry(rootLevel)
+
 	cores := make([]zapcore.Core, 0)
 
 	// add stdout log
@@ -146,7 +147,7 @@ func newLogger(opts options) *StructuredLogger {
 		stdoutCore := zapcore.NewCore(
 			encoder,
 			zapcore.Lock(os.Stdout),
-			zap.LevelEnablerFunc(opts.ZapLevelEnabled),
+			zapcore.DebugLevel,
 		)
 		cores = append(cores, stdoutCore)
 	}
@@ -156,11 +157,21 @@ func newLogger(opts options) *StructuredLogger {
 		outputCore := zapcore.NewCore(
 			encoder,
 			zapcore.Lock(zapcore.AddSync(opts.Output)),
-			zap.LevelEnablerFunc(opts.ZapLevelEnabled),
+			zapcore.DebugLevel,
 		)
 		cores = append(cores, outputCore)
 	}
 
+	// add sink cores
+	for _, sink := range opts.Sinks {
+		sinkCore := zapcore.NewCore(
+			newEncoder(sink.Format, opts.Development),
+			zapcore.Lock(zapcore.AddSync(sink.Writer)),
+			toZapLevel(sink.Level),
+		)
+		cores = append(cores, sinkCore)
+	}
+
 	zapOptions := []zap.Option{
 		zap.WithCaller(opts.AddCaller),
 		zap.AddCallerSkip(opts.CallerSkip),
@@ -171,8 +182,9 @@ func newLogger(opts options) *StructuredLogger {
 	}
 
 	l := &StructuredLogger{
-		base:    zap.New(zapcore.NewTee(cores...), zapOptions...),
+		base:    zap.New(newLevelCore(zapcore.NewTee(cores...), levels), zapOptions...),
 		options: opts,
+		levels:  levels,
 
 		debug:  (*zap.Logger).Debug,
 		info:   (*zap.Logger).Info,
@@ -185,13 +197,42 @@ func newLogger(opts options) *StructuredLogger {
 
 	if opts.Development {
 		l.print = (*zap.Logger).Debug
+		l.printLevel = DebugLevel
 	} else {
 		l.print = (*zap.Logger).Info
+		l.printLevel = InfoLevel
 	}
 
 	return l
 }
 
+func newEncoder(format Format, development bool) zapcore.Encoder {
+	var encoderCfg zapcore.EncoderConfig
+
+	if development {
+		encoderCfg = zap.NewDevelopmentEncoderConfig()
+		encoderCfg.EncodeTime = zapcore.RFC3339TimeEncoder
+		encoderCfg.EncodeCaller = zapcore.FullCallerEncoder
+	} else {
+		encoderCfg = zap.NewProductionEncoderConfig()
+		encoderCfg.TimeKey = "time"
+		encoderCfg.EncodeTime = zapcore.RFC3339TimeEncoder
+	}
+
+	switch format {
+	case FormatJSON:
+		return zapcore.NewJSONEncoder(encoderCfg)
+	case FormatLogfmt:
+		return newLogfmtEncoder(encoderCfg)
+	case FormatPretty:
+		encoderCfg.EncodeLevel = zapcore.LowercaseLevelEncoder
+
+		return newPrettyEncoder(encoderCfg)
+	default:
+		return zapcore.NewConsoleEncoder(encoderCfg)
+	}
+}
+
 // WithOptions allows configuring a logger instance with pre-defined settings.
 func (l *StructuredLogger) WithOptions(opt ...Option) *StructuredLogger {
 	opts := l.options.Clone()
@@ -203,6 +244,61 @@ func (l *StructuredLogger) WithOptions(opt ...Option) *StructuredLogger {
 	return newLogger(opts)
 }
 
+// Named adds a new path segment to the logger's name. Segments are joined by periods.
+// Named loggers share log levels with their parent and can be tuned individually
+// through SetLevel.
+func (l *StructuredLogger) Named(name string) Logger {
+	c := *l
+	c.base = l.base.Named(name)
+
+	return &c
+}
+
+// With creates a child logger which adds the given fields to every log statement.
+func (l *StructuredLogger) With(fields ...Field) Logger {
+	c := *l
+	c.base = l.base.With(fields...)
+
+	return &c
+}
+
+// Level returns the effective log level for a logger name.
+func (l *StructuredLogger) Level(name string) Level {
+	return l.levels.Level(name)
+}
+
+// SetLevel changes the log level for a logger name at runtime.
+// An empty name changes the root log level.
+func (l *StructuredLogger) SetLevel(name string, lvl Level) {
+	l.levels.SetLevel(name, lvl)
+}
+
+// UnsetLevel removes a per-name log level set through SetLevel.
+func (l *StructuredLogger) UnsetLevel(name string) {
+	l.levels.UnsetLevel(name)
+}
+
+// HasLevel checks if a log level is set explicitly for a logger name.
+func (l *StructuredLogger) HasLevel(name string) bool {
+	return l.levels.HasLevel(name)
+}
+
+// Enabled checks if a log statement with the given level would be written by this logger, taking
+// its name and all outputs into account. It allows skipping expensive fields of disabled statements.
+func (l *StructuredLogger) Enabled(lvl Level) bool {
+	return l.base.Check(toZapLevel(lvl), "") != nil
+}
+
+// PrintEnabled checks if Print would write a log statement.
+func (l *StructuredLogger) PrintEnabled() bool {
+	return l.Enabled(l.printLevel)
+}
+
+// Sync flushes any buffered log statements.
+func (l *StructuredLogger) Sync() error {
+	return l.base.Sync()
+}
+
 // Print logs a log statement with either Debug on Info log levels.
 func (l *StructuredLogger) Print(msg string, fields ...Field) {
 	l.print(l.base, msg, fields...)
@@ -0,0 +1,120 @@
+package log_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+func TestStructuredLoggerRuntimeLevel(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(
+		log.WithLevel(log.InfoLevel),
+		log.WithLogToStdout(false),
+		log.WithOutput(&buf),
+	)
+	clientLogger := logger.Named("grpc.client")
+
+	logger.Debug("root debug before")
+	clientLogger.Debug("client debug before")
+
+	logger.SetLevel("grpc", log.DebugLevel)
+
+	logger.Debug("root debug after")
+	clientLogger.Debug("client debug after")
+
+	if got := logger.Level("grpc.client"); got != log.DebugLevel {
+		t.Errorf("expected inherited level %s, got %s", log.DebugLevel, got)
+	}
+
+	if logger.HasLevel("grpc.client") {
+		t.Error("expected no explicit level for grpc.client")
+	}
+
+	logger.UnsetLevel("grpc")
+	clientLogger.Debug("client debug unset")
+
+	logger.SetLevel("", log.ErrorLevel)
+	logger.Info("root info error level")
+
+	out := buf.String()
+
+	for _, msg := range []string{"root debug before", "client debug before", "root debug after",
+		"client debug unset", "root info error level"} {
+		if strings.Contains(out, msg) {
+			t.Errorf("did not expect %q to be logged", msg)
+		}
+	}
+
+	if !strings.Contains(out, "client debug after") {
+		t.Errorf("expected %q to be logged", "client debug after")
+	}
+}
+
+func TestStructuredLoggerEnabled(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(log.WithLevel(log.WarnLevel), log.WithLogToStdout(false), log.WithOutput(&buf))
+	clientLogger := logger.Named("grpc.client")
+
+	if logger.PrintEnabled() || clientLogger.Enabled(log.InfoLevel) || !clientLogger.Enabled(log.WarnLevel) {
+		t.Error("expected only warn and above to be enabled")
+	}
+
+	logger.SetLevel("grpc", log.DebugLevel)
+
+	if !clientLogger.PrintEnabled() || !clientLogger.Enabled(log.DebugLevel) || logger.PrintEnabled() {
+		t.Error("expected print and debug to be enabled for grpc.client only")
+	}
+
+	development := log.New(log.WithDevelopment(true), log.WithLogToStdout(false), log.WithOutput(&buf))
+	development.SetLevel("", log.InfoLevel)
+
+	if development.PrintEnabled() {
+		t.Error("expected print to log at debug level in development")
+	}
+}
+
+func TestStructuredLoggerSinks(t *testing.T) {
+	var consoleBuf bytes.Buffer
+
+	path := filepath.Join(t.TempDir(), "app.log")
+	fileWriter := log.NewFileWriter(log.FileConfig{Path: path, MaxSizeMB: 1, MaxBackups: 1})
+
+	logger := log.New(
+		log.WithLevel(log.DebugLevel),
+		log.WithLogToStdout(false),
+		log.WithSink(log.Sink{Writer: &consoleBuf, Format: log.FormatConsole, Level: log.DebugLevel}),
+		log.WithSink(log.Sink{Writer: fileWriter, Format: log.FormatJSON, Level: log.WarnLevel}),
+	)
+
+	logger.Debug("debug statement")
+	logger.Warn("warn statement")
+
+	if err := fileWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fileContents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(fileContents), "debug statement") {
+		t.Error("did not expect debug statement in the file sink")
+	}
+
+	if !strings.Contains(string(fileContents), `"msg":"warn statement"`) {
+		t.Errorf("expected a JSON warn statement in the file sink, got %s", fileContents)
+	}
+
+	if !strings.Contains(consoleBuf.String(), "debug statement") ||
+		!strings.Contains(consoleBuf.String(), "warn statement") {
+		t.Errorf("expected both statements in the console sink, got %s", consoleBuf.String())
+	}
+}
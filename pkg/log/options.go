@@ -12,6 +12,7 @@ type options struct {
 	Encoder     zapcore.Encoder
 	Development bool
 	Output      io.Writer
+	Sinks       []Sink
 	LogToStdout bool
 	AddCaller   bool
 	CallerSkip  int
@@ -23,6 +24,7 @@ func (o options) Clone() options {
 		Format:      o.Format,
 		Development: o.Development,
 		Output:      o.Output,
+		Sinks:       append([]Sink(nil), o.Sinks...),
 		LogToStdout: o.LogToStdout,
 		AddCaller:   o.AddCaller,
 		CallerSkip:  o.CallerSkip,
@@ -35,11 +37,6 @@ func (o options) Clone() options {
 	return c
 }
 
-// ZapLevelEnabled checks if a zap log level is set directly or indirectly.
-func (o options) ZapLevelEnabled(lvl zapcore.Level) bool {
-	return o.Development || o.Level.Enabled(fromZapLevel(lvl))
-}
-
 // Option represents logger configuration options.
 type Option interface {
 	apply(*options)
@@ -91,6 +88,13 @@ func WithOutput(output io.Writer) Option {
 	})
 }
 
+// WithSink adds a log destination with its own format and log level.
+func WithSink(sink Sink) Option {
+	return optionFunc(func(l *options) {
+		l.Sinks = append(l.Sinks, sink)
+	})
+}
+
 // ToStdout sets log output to stdout.
 func ToStdout() Option {
 	return WithLogToStdout(true)
@@ -0,0 +1,164 @@
+package log
+
+import (
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// LevelController changes log levels of a running logger, either for the root
+// logger or for a named logger and all of its descendants.
+type LevelController interface {
+	// Level returns the effective log level for a logger name.
+	// An empty name refers to the root logger.
+	Level(name string) Level
+	// SetLevel sets the log level for a logger name.
+	// An empty name refers to the root logger.
+	SetLevel(name string, lvl Level)
+	// UnsetLevel removes a per-name log level so that the logger inherits
+	// the level of its closest configured ancestor again.
+	UnsetLevel(name string)
+	// HasLevel checks if a log level is set explicitly for a logger name.
+	HasLevel(name string) bool
+}
+
+// levelRegistry holds the root log level and per-name overrides shared by a logger
+// and all loggers derived from it.
+type levelRegistry struct {
+	mu    sync.RWMutex
+	root  Level
+	named map[string]Level
+	// min is the lowest level enabled by the root level or any override.
+	min Level
+}
+
+func newLevelRegistry(root Level) *levelRegistry {
+	return &levelRegistry{
+		root:  root,
+		named: make(map[string]Level),
+		min:   root,
+	}
+}
+
+// Level implements LevelController.
+func (r *levelRegistry) Level(name string) Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.lookup(name)
+}
+
+// SetLevel implements LevelController.
+func (r *levelRegistry) SetLevel(name string, lvl Level) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if name == "" {
+		r.root = lvl
+	} else {
+		r.named[name] = lvl
+	}
+
+	r.recalculateMin()
+}
+
+// UnsetLevel implements LevelController.
+func (r *levelRegistry) UnsetLevel(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.named, name)
+	r.recalculateMin()
+}
+
+// HasLevel implements LevelController.
+func (r *levelRegistry) HasLevel(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if name == "" {
+		return true
+	}
+
+	_, ok := r.named[name]
+
+	return ok
+}
+
+// enabled checks if a zap log level is enabled for a logger name.
+func (r *levelRegistry) enabled(name string, lvl zapcore.Level) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.lookup(name).Enabled(fromZapLevel(lvl))
+}
+
+// anyEnabled checks if a zap log level is enabled for at least one logger name.
+func (r *levelRegistry) anyEnabled(lvl zapcore.Level) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return toZapLevel(r.min).Enabled(lvl)
+}
+
+// lookup walks up a dot-separated logger name until it finds a configured level.
+func (r *levelRegistry) lookup(name string) Level {
+	for name != "" {
+		if lvl, ok := r.named[name]; ok {
+			return lvl
+		}
+
+		idx := strings.LastIndexByte(name, '.')
+		if idx < 0 {
+			break
+		}
+
+		name = name[:idx]
+	}
+
+	return r.root
+}
+
+func (r *levelRegistry) recalculateMin() {
+	r.min = r.root
+
+	for _, lvl := range r.named {
+		if lvl < r.min {
+			r.min = lvl
+		}
+	}
+}
+
+// levelCore filters log entries through a levelRegistry before handing them over
+// to the wrapped core.
+type levelCore struct {
+	zapcore.Core
+	levels *levelRegistry
+}
+
+func newLevelCore(core zapcore.Core, levels *levelRegistry) zapcore.Core {
+	return &levelCore{
+		Core:   core,
+		levels: levels,
+	}
+}
+
+// Enabled implements zapcore.LevelEnabler.
+func (c *levelCore) Enabled(lvl zapcore.Level) bool {
+	return c.levels.anyEnabled(lvl) && c.Core.Enabled(lvl)
+}
+
+// With implements zapcore.Core.
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return newLevelCore(c.Core.With(fields), c.levels)
+}
+
+// Check implements zapcore.Core.
+func (c *levelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.levels.enabled(ent.LoggerName, ent.Level) {
+		return ce
+	}
+
+	return c.Core.Check(ent, ce)
+}
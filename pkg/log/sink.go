@@ -0,0 +1,41 @@
+package log
+
+import (
+	"io"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Sink is an additional log destination with its own format and minimum log level.
+// Sink levels are applied on top of the runtime log levels of the logger.
+type Sink struct {
+	Writer io.Writer
+	Format Format
+	Level  Level
+}
+
+// FileConfig holds the settings for a size and age rotated log file.
+type FileConfig struct {
+	// Path is the file to write logs to. Rotated backups are kept in the same directory.
+	Path string
+	// MaxSizeMB is the maximum size in megabytes of the log file before it gets rotated.
+	MaxSizeMB int
+	// MaxAgeDays is the maximum number of days to retain rotated files. Zero keeps them forever.
+	MaxAgeDays int
+	// MaxBackups is the maximum number of rotated files to retain. Zero keeps all of them.
+	MaxBackups int
+	// Compress enables gzip compression of rotated files.
+	Compress bool
+}
+
+// NewFileWriter creates a log writer which rotates files by size and prunes them by age and count.
+func NewFileWriter(conf FileConfig) io.WriteCloser {
+	return &lumberjack.Logger{
+		Filename:   conf.Path,
+		MaxSize:    conf.MaxSizeMB,
+		MaxAge:     conf.MaxAgeDays,
+		MaxBackups: conf.MaxBackups,
+		Compress:   conf.Compress,
+		LocalTime:  false,
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !windows && !plan9
+
+package log
+
+import (
+	"io"
+	"log/syslog"
+)
+
+// NewSyslogWriter creates a log writer which sends log statements to the local syslog daemon
+// over its unix socket. The log statements keep their own encoded level.
+func NewSyslogWriter(tag string) (io.WriteCloser, error) {
+	return syslog.New(syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+}
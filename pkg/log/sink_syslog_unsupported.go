@@ -0,0 +1,13 @@
+//go:build windows || plan9
+
+package log
+
+import (
+	"errors"
+	"io"
+)
+
+// NewSyslogWriter is not supported on this platform.
+func NewSyslogWriter(tag string) (io.WriteCloser, error) {
+	return nil, errors.New("syslog is not supported on this platform")
+}
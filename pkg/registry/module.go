@@ -0,0 +1,11 @@
+package registry
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// InitializeInterfaceRegistry configures the InterfaceRegistry module used for resolving
+// google.protobuf.Any values in upstream responses.
+func InitializeInterfaceRegistry(registrars ...Registrar) codectypes.InterfaceRegistry {
+	return NewInterfaceRegistry(registrars...)
+}
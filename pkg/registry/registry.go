@@ -0,0 +1,92 @@
+package registry
+
+import (
+	"sync"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Registrar registers interfaces and their implementations to an InterfaceRegistry.
+type Registrar func(registry codectypes.InterfaceRegistry)
+
+var (
+	_chainRegistrarsMu sync.Mutex
+	_chainRegistrars   []Registrar
+)
+
+// Register adds a chain-specific registrar which is applied to every InterfaceRegistry
+// created afterwards by NewInterfaceRegistry. It is meant to be called from init functions
+// of packages holding chain-specific types.
+func Register(r Registrar) {
+	_chainRegistrarsMu.Lock()
+	defer _chainRegistrarsMu.Unlock()
+
+	_chainRegistrars = append(_chainRegistrars, r)
+}
+
+// StandardRegistrars returns the registrars of the standard Cosmos SDK types:
+// crypto keys, transactions and the messages, proposals and accounts of all SDK modules.
+func StandardRegistrars() []Registrar {
+	return []Registrar{
+		std.RegisterInterfaces,
+		authtypes.RegisterInterfaces,
+		vestingtypes.RegisterInterfaces,
+		authz.RegisterInterfaces,
+		banktypes.RegisterInterfaces,
+		consensustypes.RegisterInterfaces,
+		crisistypes.RegisterInterfaces,
+		distrtypes.RegisterInterfaces,
+		evidencetypes.RegisterInterfaces,
+		feegrant.RegisterInterfaces,
+		govv1.RegisterInterfaces,
+		govv1beta1.RegisterInterfaces,
+		group.RegisterInterfaces,
+		minttypes.RegisterInterfaces,
+		paramsproposal.RegisterInterfaces,
+		slashingtypes.RegisterInterfaces,
+		stakingtypes.RegisterInterfaces,
+		upgradetypes.RegisterInterfaces,
+	}
+}
+
+// NewInterfaceRegistry creates an InterfaceRegistry pre-populated with the standard Cosmos SDK types,
+// the chain-specific types added through Register and the passed registrars.
+func NewInterfaceRegistry(registrars ...Registrar) codectypes.InterfaceRegistry {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+
+	for _, r := range StandardRegistrars() {
+		r(interfaceRegistry)
+	}
+
+	_chainRegistrarsMu.Lock()
+	chainRegistrars := append([]Registrar(nil), _chainRegistrars...)
+	_chainRegistrarsMu.Unlock()
+
+	for _, r := range chainRegistrars {
+		r(interfaceRegistry)
+	}
+
+	for _, r := range registrars {
+		r(interfaceRegistry)
+	}
+
+	return interfaceRegistry
+}
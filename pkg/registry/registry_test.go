@@ -0,0 +1,68 @@
+package registry_test
+
+import (
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	pb "github.com/powerslider/cosmos-grpc-forwarder/client/grpc/api/cosmos/forwarder/v1"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/registry"
+)
+
+func TestNewInterfaceRegistry(t *testing.T) {
+	var called bool
+
+	interfaceRegistry := registry.NewInterfaceRegistry(func(r codectypes.InterfaceRegistry) {
+		called = true
+	})
+
+	if !called {
+		t.Error("expected the passed registrar to be called")
+	}
+
+	for _, msg := range []proto.Message{&ed25519.PubKey{}, &banktypes.MsgSend{}} {
+		typeURL := "/" + proto.MessageName(msg)
+
+		if _, err := interfaceRegistry.Resolve(typeURL); err != nil {
+			t.Errorf("expected %s to be resolved: %v", typeURL, err)
+		}
+	}
+
+	var sdkMsg sdk.Msg
+	if err := interfaceRegistry.UnpackAny(mustAny(t, &banktypes.MsgSend{FromAddress: "a"}), &sdkMsg); err != nil {
+		t.Errorf("expected MsgSend to be unpacked as sdk.Msg: %v", err)
+	}
+}
+
+func TestJSONConverterResolvesAny(t *testing.T) {
+	converter := jsonconv.NewJSONConverter(jsonconv.WithInterfaceRegistry(registry.NewInterfaceRegistry()))
+
+	got, err := converter.Marshal(&pb.Validator{
+		Address: "osmovalcons1",
+		PubKey:  mustAny(t, &ed25519.PubKey{Key: []byte{1, 2, 3}}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"pubKey":{"@type":"/cosmos.crypto.ed25519.PubKey","key":"AQID"}`
+	if !strings.Contains(string(got), want) {
+		t.Errorf("expected %s in %s", want, got)
+	}
+}
+
+func mustAny(t *testing.T, msg proto.Message) *codectypes.Any {
+	t.Helper()
+
+	a, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a
+}
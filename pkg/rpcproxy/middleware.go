@@ -0,0 +1,195 @@
+package rpcproxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// _maxPeekBodyBytes limits how much of a JSON-RPC request body is buffered to find the called method.
+const _maxPeekBodyBytes = 1 << 20
+
+// Middleware wraps an http.Handler.
+type Middleware func(http.Handler) http.Handler
+
+// Chain applies middlewares so that the first one is the outermost.
+func Chain(h http.Handler, middlewares ...Middleware) http.Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+
+	return h
+}
+
+// NewRequestIDMiddleware propagates the x-request-id header or generates a new request ID if the
+// header is missing. The request ID is echoed back in the response headers, forwarded upstream
+// and added to the request-scoped logger stored in the context.
+func NewRequestIDMiddleware(logger log.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(logging.RequestIDHeader)
+			if requestID == "" || len(requestID) > logging.MaxRequestIDLength {
+				requestID = logging.NewRequestID()
+			}
+
+			r.Header.Set(logging.RequestIDHeader, requestID)
+			w.Header().Set(logging.RequestIDHeader, requestID)
+
+			ctx := logging.NewRequestIDContext(r.Context(), requestID)
+			ctx = log.NewContext(ctx, logger.With(log.String("request_id", requestID)))
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// NewLoggingMiddleware logs every proxied request with its JSON-RPC method, status and duration.
+func NewLoggingMiddleware(logger log.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestLogger := log.FromContext(r.Context(), logger)
+			rpcMethod := peekRPCMethod(r)
+
+			recorder := newResponseRecorder(w)
+
+			start := time.Now()
+			next.ServeHTTP(recorder, r)
+			duration := time.Since(start)
+
+			fields := []log.Field{
+				log.String("method", rpcMethod),
+				log.String("http_method", r.Method),
+				log.String("path", r.URL.Path),
+				log.String("chain_id", recorder.chainID),
+				log.String("upstream", recorder.upstream),
+				log.Int("status", recorder.status),
+				log.Float64("duration", duration.Seconds()),
+			}
+
+			if recorder.status >= http.StatusBadRequest {
+				requestLogger.Warn("CometBFT RPC request", fields...)
+			} else {
+				requestLogger.Print("CometBFT RPC request", fields...)
+			}
+		})
+	}
+}
+
+// NewAccessLogMiddleware writes one access log entry per request with the same schema as
+// the gRPC access log, so that both front ends can be shipped to the same sink.
+func NewAccessLogMiddleware(accessLogger log.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rpcMethod := peekRPCMethod(r)
+			recorder := newResponseRecorder(w)
+
+			start := time.Now()
+			next.ServeHTTP(recorder, r)
+			duration := time.Since(start)
+
+			var errMsg string
+			if recorder.status >= http.StatusBadRequest {
+				errMsg = http.StatusText(recorder.status)
+			}
+
+			fields := []log.Field{
+				log.String("request_id", logging.RequestIDFromContext(r.Context())),
+				log.String("method", rpcMethod),
+				log.String("peer", r.RemoteAddr),
+				log.String("user_agent", r.UserAgent()),
+				log.String("code", strconv.Itoa(recorder.status)),
+				log.String("error", errMsg),
+				log.Float64("duration_ms", float64(duration)/float64(time.Millisecond)),
+				log.Int64("request_bytes", r.ContentLength),
+				log.Int("response_bytes", recorder.written),
+			}
+
+			if errMsg != "" {
+				accessLogger.Warn("access", fields...)
+			} else {
+				accessLogger.Info("access", fields...)
+			}
+		})
+	}
+}
+
+// peekRPCMethod returns the called JSON-RPC method. URI-style requests use the path as method,
+// websocket connections are reported as "subscribe" and POST bodies are decoded and restored.
+func peekRPCMethod(r *http.Request) string {
+	if isWebsocketUpgrade(r) {
+		return "subscribe"
+	}
+
+	if r.Method != http.MethodPost || r.Body == nil {
+		return strings.TrimPrefix(r.URL.Path, "/")
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, _maxPeekBodyBytes))
+	if err != nil {
+		return ""
+	}
+
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+
+	var call struct {
+		Method string `json:"method"`
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return "batch"
+	}
+
+	if err := json.Unmarshal(trimmed, &call); err != nil {
+		return ""
+	}
+
+	return call.Method
+}
+
+func isWebsocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// responseRecorder captures the response status and the selected upstream.
+// It unwraps to the original http.ResponseWriter so that websocket upgrades can hijack the connection.
+type responseRecorder struct {
+	http.ResponseWriter
+	status   int
+	written  int
+	chainID  string
+	upstream string
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{
+		ResponseWriter: w,
+		status:         http.StatusOK,
+	}
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.written += n
+
+	return n, err
+}
+
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
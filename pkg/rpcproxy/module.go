@@ -0,0 +1,63 @@
+package rpcproxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// InitializeNewRPCProxyServer initializes the CometBFT RPC proxy server module. Its upstreams
+// are checked once and then every UPSTREAM_CHECK_INTERVAL, like the gRPC upstreams.
+// Requests are written to accessLogger, unless it is nil.
+// It returns nil when no CometBFT RPC upstreams are configured.
+func InitializeNewRPCProxyServer(
+	ctx context.Context,
+	conf *configs.Config,
+	logger log.Logger,
+	accessLogger *log.StructuredLogger,
+) *Server {
+	if len(conf.CometBFTRPCUpstreams) == 0 {
+		return nil
+	}
+
+	proxy, err := NewProxy(conf.CometBFTRPCUpstreams, conf.DefaultChainID, logger.Named("rpc.proxy"))
+	if err != nil {
+		logger.Panic("error: cannot create CometBFT RPC proxy: ", log.Error(err))
+	}
+
+	guard := upstream.NewGuardWithCheck(
+		proxy.Router(), proxy.CheckUpstream, conf.UpstreamCheckInterval, logger.Named("rpc.upstream"))
+	guard.Check(ctx)
+
+	go guard.Run(ctx)
+
+	serverAddress := fmt.Sprintf("%s:%d", conf.RPCProxyHost, conf.RPCProxyPort)
+
+	lis, err := net.Listen("tcp", serverAddress)
+	if err != nil {
+		logger.Panic("error: cannot create CometBFT RPC proxy listener: ", log.Error(err))
+	}
+
+	middlewares := []Middleware{
+		NewRequestIDMiddleware(logger.Named("rpc.server")),
+	}
+
+	if accessLogger != nil {
+		middlewares = append(middlewares, NewAccessLogMiddleware(accessLogger.Named("access")))
+	}
+
+	middlewares = append(middlewares, NewLoggingMiddleware(logger.Named("rpc.server")))
+
+	return NewServer(
+		conf.ServerName+"-rpc",
+		serverAddress,
+		lis,
+		logger,
+		proxy,
+		middlewares,
+	)
+}
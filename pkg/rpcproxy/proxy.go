@@ -0,0 +1,173 @@
+package rpcproxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+type upstreamContextKey struct{}
+
+// Proxy is a reverse proxy for CometBFT JSON-RPC endpoints. It forwards plain HTTP requests,
+// JSON-RPC POST requests and websocket subscriptions (/websocket) to the configured upstreams.
+//
+// The chain is selected by a chain ID path prefix, e.g. /osmosis-1/status, or by the x-chain-id
+// header. Requests without either go to the default chain. Upstreams of a chain are picked
+// in round-robin order from an upstream.Pool, so upstreams quarantined by a Guard running
+// CheckUpstream are skipped.
+type Proxy struct {
+	router       *upstream.Router
+	targets      map[*upstream.Upstream]*url.URL
+	reverseProxy *httputil.ReverseProxy
+	logger       log.Logger
+}
+
+// NewProxy is a constructor function for Proxy. Upstreams are given as chain specs in the format
+// "chain-id=url,url" or "url,url" for the default chain. With a single chain and no explicit
+// default, that chain becomes the default.
+func NewProxy(upstreams []string, defaultChainID string, logger log.Logger) (*Proxy, error) {
+	chains, err := upstream.ParseChains(upstreams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chains) == 0 {
+		return nil, errors.New("no CometBFT RPC upstreams configured")
+	}
+
+	p := &Proxy{
+		targets: make(map[*upstream.Upstream]*url.URL),
+		logger:  logger,
+	}
+
+	pools := make([]*upstream.Pool, 0, len(chains))
+
+	for _, chain := range chains {
+		chainUpstreams := make([]*upstream.Upstream, 0, len(chain.Endpoints))
+
+		for _, endpoint := range chain.Endpoints {
+			upstreamURL, err := url.Parse(endpoint)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid CometBFT RPC upstream %q", endpoint)
+			}
+
+			// CometBFT itself advertises its RPC listen address as tcp://host:port.
+			if upstreamURL.Scheme == "tcp" {
+				upstreamURL.Scheme = "http"
+			}
+
+			if upstreamURL.Scheme != "http" && upstreamURL.Scheme != "https" {
+				return nil, errors.Errorf("invalid CometBFT RPC upstream %q: scheme must be http, https or tcp", endpoint)
+			}
+
+			u := &upstream.Upstream{Endpoint: endpoint}
+			p.targets[u] = upstreamURL
+			chainUpstreams = append(chainUpstreams, u)
+		}
+
+		pools = append(pools, upstream.NewPool(chain.ChainID, chainUpstreams...))
+	}
+
+	p.router = upstream.NewRouter(defaultChainID, pools...)
+
+	// Websocket upgrades are handled by httputil.ReverseProxy itself: the upgraded connection
+	// is hijacked and bytes are copied in both directions until either side closes it.
+	p.reverseProxy = &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(UpstreamFromContext(r.In.Context()))
+			r.SetXForwarded()
+		},
+		ErrorHandler: p.handleError,
+	}
+
+	return p, nil
+}
+
+// Router returns the router holding the upstream pools of every chain.
+func (p *Proxy) Router() *upstream.Router {
+	return p.router
+}
+
+// ServeHTTP implements http.Handler.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pool, path, err := p.route(r)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatus(err))
+
+		return
+	}
+
+	u, err := pool.Next()
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatus(err))
+
+		return
+	}
+
+	target := p.targets[u]
+
+	if recorder, ok := w.(*responseRecorder); ok {
+		recorder.chainID = pool.ChainID
+		recorder.upstream = target.Host
+	}
+
+	r = r.WithContext(context.WithValue(r.Context(), upstreamContextKey{}, target))
+	r.URL.Path = path
+	r.URL.RawPath = ""
+
+	p.reverseProxy.ServeHTTP(w, r)
+}
+
+// route selects the upstream pool of a request and returns the request path without the chain ID prefix.
+func (p *Proxy) route(r *http.Request) (*upstream.Pool, string, error) {
+	trimmed := strings.TrimPrefix(r.URL.Path, "/")
+	prefix, rest, _ := strings.Cut(trimmed, "/")
+
+	if prefix != "" {
+		if pool, err := p.router.Pool(prefix); err == nil {
+			return pool, "/" + rest, nil
+		}
+	}
+
+	pool, err := p.router.Pool(r.Header.Get(upstream.ChainIDHeader))
+
+	return pool, r.URL.Path, err
+}
+
+// UpstreamFromContext returns the upstream selected for the request or nil.
+func UpstreamFromContext(ctx context.Context) *url.URL {
+	upstream, _ := ctx.Value(upstreamContextKey{}).(*url.URL)
+
+	return upstream
+}
+
+func (p *Proxy) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	log.FromContext(r.Context(), p.logger).Warn("upstream call failed",
+		log.String("upstream", UpstreamFromContext(r.Context()).Host),
+		log.String("path", r.URL.Path),
+		log.Error(err),
+	)
+
+	w.WriteHeader(http.StatusBadGateway)
+}
+
+// httpStatus maps the gRPC status of a routing error to an HTTP status code.
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusServiceUnavailable
+	}
+}
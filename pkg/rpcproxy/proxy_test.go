@@ -0,0 +1,270 @@
+package rpcproxy_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/logging"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/rpcproxy"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+func newTestUpstream(t *testing.T, name string) *httptest.Server {
+	t.Helper()
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			conn, rw, err := http.NewResponseController(w).Hijack()
+			if err != nil {
+				t.Error(err)
+
+				return
+			}
+			defer conn.Close()
+
+			_, _ = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+			_ = rw.Flush()
+
+			line, _ := rw.ReadString('\n')
+			_, _ = rw.WriteString(name + ":" + line)
+			_ = rw.Flush()
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(name + " " + r.Header.Get(logging.RequestIDHeader) + " " + r.URL.Path + " " + string(body)))
+	}))
+	t.Cleanup(upstream.Close)
+
+	return upstream
+}
+
+func newTestProxy(t *testing.T, upstreams ...string) *httptest.Server {
+	t.Helper()
+
+	logger := log.InitializeLogger("error", "json")
+
+	proxy, err := rpcproxy.NewProxy(upstreams, "", logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	front := httptest.NewServer(rpcproxy.Chain(proxy,
+		rpcproxy.NewRequestIDMiddleware(logger),
+		rpcproxy.NewAccessLogMiddleware(logger),
+		rpcproxy.NewLoggingMiddleware(logger),
+	))
+	t.Cleanup(front.Close)
+
+	return front
+}
+
+func TestProxyForwardsRequests(t *testing.T) {
+	a := newTestUpstream(t, "a")
+	b := newTestUpstream(t, "b")
+	front := newTestProxy(t, a.URL+","+b.URL)
+
+	var got []string
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodPost, front.URL+"/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"status"}`))
+		req.Header.Set(logging.RequestIDHeader, "req-1")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.Header.Get(logging.RequestIDHeader) != "req-1" {
+			t.Errorf("expected request ID to be echoed, got %q", resp.Header.Get(logging.RequestIDHeader))
+		}
+
+		got = append(got, string(body))
+	}
+
+	want := []string{
+		`a req-1 / {"jsonrpc":"2.0","id":1,"method":"status"}`,
+		`b req-1 / {"jsonrpc":"2.0","id":1,"method":"status"}`,
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("response %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+}
+
+func TestProxyUpstreamDown(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	front := newTestProxy(t, down.URL)
+
+	resp, err := http.Get(front.URL + "/status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status %d, got %d", http.StatusBadGateway, resp.StatusCode)
+	}
+}
+
+func TestProxyWebsocketUpgrade(t *testing.T) {
+	front := newTestProxy(t, newTestUpstream(t, "a").URL)
+
+	req, _ := http.NewRequest(http.MethodGet, front.URL+"/websocket", nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("expected status %d, got %d", http.StatusSwitchingProtocols, resp.StatusCode)
+	}
+
+	conn, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		t.Fatalf("expected upgraded connection, got %T", resp.Body)
+	}
+
+	if _, err := conn.Write([]byte("subscribe\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if line != "a:subscribe\n" {
+		t.Errorf("expected %q, got %q", "a:subscribe\n", line)
+	}
+}
+
+func TestNewProxyInvalidUpstream(t *testing.T) {
+	logger := log.InitializeLogger("error", "json")
+
+	if _, err := rpcproxy.NewProxy(nil, "", logger); err == nil {
+		t.Error("expected error for no upstreams")
+	}
+
+	if _, err := rpcproxy.NewProxy([]string{"ws://localhost:26657"}, "", logger); err == nil {
+		t.Error("expected error for unsupported scheme")
+	}
+}
+
+func TestProxyRoutesByChainID(t *testing.T) {
+	osmosis := newTestUpstream(t, "osmosis")
+	hub := newTestUpstream(t, "hub")
+	front := newTestProxy(t, "osmosis-1="+osmosis.URL, "cosmoshub-4="+hub.URL)
+
+	tests := []struct {
+		name    string
+		path    string
+		chainID string
+		status  int
+		want    string
+	}{
+		{name: "path prefix", path: "/osmosis-1/status", status: http.StatusOK, want: "osmosis  /status "},
+		{name: "header", path: "/block", chainID: "cosmoshub-4", status: http.StatusOK, want: "hub  /block "},
+		{name: "unknown chain", path: "/status", chainID: "juno-1", status: http.StatusNotFound},
+		{name: "no chain", path: "/status", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, front.URL+tt.path, nil)
+			req.Header.Set("x-chain-id", tt.chainID)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, resp.StatusCode)
+			}
+
+			if tt.want == "" {
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if got := strings.Replace(string(body), resp.Header.Get(logging.RequestIDHeader), "", 1); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProxySkipsQuarantinedUpstreams(t *testing.T) {
+	newStatusUpstream := func(name, network string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/status" {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":-1,"result":{"node_info":{"network":"` + network + `"}}}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(name))
+		}))
+		t.Cleanup(server.Close)
+
+		return server
+	}
+
+	good := newStatusUpstream("good", "osmosis-1")
+	wrong := newStatusUpstream("wrong", "osmo-test-5")
+	logger := log.InitializeLogger("error", "json")
+
+	proxy, err := rpcproxy.NewProxy([]string{"osmosis-1=" + good.URL + "," + wrong.URL, "juno-1=" + wrong.URL}, "", logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	upstream.NewGuardWithCheck(proxy.Router(), proxy.CheckUpstream, 0, logger).Check(context.Background())
+
+	front := httptest.NewServer(proxy)
+	t.Cleanup(front.Close)
+
+	for i := 0; i < 3; i++ {
+		resp, err := http.Get(front.URL + "/osmosis-1/block")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if string(body) != "good" {
+			t.Errorf("request %d: expected the upstream of another network to be skipped, got %q", i, body)
+		}
+	}
+
+	resp, err := http.Get(front.URL + "/juno-1/block")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d with every upstream quarantined, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+}
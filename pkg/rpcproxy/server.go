@@ -0,0 +1,91 @@
+package rpcproxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// Server contains all needed parameters for instantiating a new CometBFT RPC proxy server.
+type Server struct {
+	Name           string
+	Addr           string
+	Listener       net.Listener
+	serverInstance *http.Server
+	logger         log.Logger
+}
+
+// NewServer creates a new instance of rpcproxy.Server.
+func NewServer(
+	name string,
+	addr string,
+	listener net.Listener,
+	logger log.Logger,
+	handler http.Handler,
+	middlewares []Middleware,
+) *Server {
+	return &Server{
+		Name:     name,
+		Addr:     addr,
+		Listener: listener,
+		logger:   logger,
+		serverInstance: &http.Server{
+			Handler:           Chain(handler, middlewares...),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// Start starts an instantiated rpcproxy.Server.
+func (s *Server) Start(ctx context.Context, errChan chan error) {
+	s.logger.Info(fmt.Sprintf("[Start] %s %s server starting on %s\n", s.Name, "CometBFT RPC", s.Addr))
+
+	if err := s.serverInstance.Serve(s.Listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		errChan <- err
+	}
+}
+
+// Shutdown performs a graceful shutdown of an instance of rpcproxy.Server.
+// Hijacked websocket connections are not tracked by the HTTP server and are closed with the process.
+func (s *Server) Shutdown(ctx context.Context) {
+	s.logger.Info(fmt.Sprintf("[Shutdown] %s CometBFT RPC server is shutting down\n", s.Name))
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err := s.serverInstance.Shutdown(ctxWithTimeout); err != nil {
+		s.logger.Info("Timed out waiting for server to close.")
+
+		return
+	}
+
+	s.logger.Info("Server gracefully stopped.")
+}
+
+// Run manages the CometBFT RPC proxy server lifecycle on start and on shutdown.
+func (s *Server) Run(ctx context.Context) error {
+	errChan := make(chan error)
+
+	go s.Start(ctx, errChan)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case <-sigs:
+		s.Shutdown(ctx)
+
+		return nil
+	case err := <-errChan:
+		return errors.WithStack(err)
+	}
+}
@@ -0,0 +1,57 @@
+package rpcproxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// statusResponse is the part of the CometBFT /status JSON-RPC response read by CheckUpstream.
+type statusResponse struct {
+	Result struct {
+		NodeInfo struct {
+			Network string `json:"network"`
+		} `json:"node_info"`
+	} `json:"result"`
+}
+
+// CheckUpstream calls /status on a CometBFT RPC upstream of the proxy and checks that it serves
+// the given chain ID. An empty chain ID skips the check. It is the upstream.CheckFunc of the guard
+// of the proxy. CometBFT RPC doesn't report the Cosmos SDK version of the application, so unlike
+// upstream.CheckUpstream it never returns upstream.ErrVersionMismatch.
+func (p *Proxy) CheckUpstream(ctx context.Context, chainID string, u *upstream.Upstream) error {
+	target, ok := p.targets[u]
+	if !ok {
+		return errors.Errorf("%s is not an upstream of the proxy", u.Endpoint)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.JoinPath("status").String(), nil)
+	if err != nil {
+		return errors.Wrapf(err, "cannot query status of %s", u.Endpoint)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "cannot query status of %s", u.Endpoint)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("cannot query status of %s: %s", u.Endpoint, resp.Status)
+	}
+
+	var statusResp statusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&statusResp); err != nil {
+		return errors.Wrapf(err, "cannot decode status of %s", u.Endpoint)
+	}
+
+	if network := statusResp.Result.NodeInfo.Network; chainID != "" && network != chainID {
+		return errors.Wrapf(upstream.ErrChainIDMismatch, "%s serves %q instead of %q", u.Endpoint, network, chainID)
+	}
+
+	return nil
+}
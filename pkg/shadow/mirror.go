@@ -0,0 +1,312 @@
+package shadow
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsondiff"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// _maxLoggedDifferences caps the differences logged per mismatch, since whole blocks can differ.
+const _maxLoggedDifferences = 20
+
+// _neverMirrored are methods with side effects which must reach one upstream only.
+var _neverMirrored = map[string]bool{
+	"/cosmos.tx.v1beta1.Service/BroadcastTx": true,
+}
+
+type mirroredContextKey struct{}
+
+// WithMirroring marks the upstream calls made with ctx as eligible for mirroring. Calls without
+// the mark, like the consistency checks of the upstream guard, are never mirrored.
+func WithMirroring(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mirroredContextKey{}, true)
+}
+
+func mirroringEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(mirroredContextKey{}).(bool)
+
+	return enabled
+}
+
+// mirroredConn marks every call made through it with WithMirroring.
+type mirroredConn struct {
+	grpc.ClientConnInterface
+}
+
+// NewMirroredConn wraps conn so that its unary calls are eligible for mirroring.
+func NewMirroredConn(conn grpc.ClientConnInterface) grpc.ClientConnInterface {
+	return &mirroredConn{ClientConnInterface: conn}
+}
+
+// Invoke implements grpc.ClientConnInterface.
+func (c *mirroredConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	return c.ClientConnInterface.Invoke(WithMirroring(ctx), method, args, reply, opts...)
+}
+
+// Stats counts mirrored calls by outcome.
+type Stats struct {
+	// Mirrored calls were sent to the candidate.
+	Mirrored uint64
+	// Matched calls got the same response or error code from both upstreams.
+	Matched uint64
+	// Mismatched calls got different responses or error codes.
+	Mismatched uint64
+	// Failed calls could not be compared because the candidate was unreachable or timed out.
+	Failed uint64
+	// Dropped calls were sampled but not mirrored because too many mirrored calls were in flight.
+	Dropped uint64
+}
+
+// Mirror sends a sample of the upstream calls of one chain to a candidate upstream as well and
+// compares the responses. Mirrored calls run in the background after the primary call has
+// returned, so the candidate never affects the response to the client.
+type Mirror struct {
+	chainID       string
+	endpoint      string
+	conn          grpc.ClientConnInterface
+	percent       float64
+	timeout       time.Duration
+	slots         chan struct{}
+	jsonConverter *jsonconv.JSONConverter
+	logger        log.Logger
+	wg            sync.WaitGroup
+
+	mirrored   atomic.Uint64
+	matched    atomic.Uint64
+	mismatched atomic.Uint64
+	failed     atomic.Uint64
+	dropped    atomic.Uint64
+}
+
+// NewMirror is a constructor function for Mirror. percent is the share of calls to mirror
+// from 0 to 100, and at most maxInFlight mirrored calls run at the same time.
+func NewMirror(
+	chainID string,
+	endpoint string,
+	conn grpc.ClientConnInterface,
+	percent float64,
+	maxInFlight int,
+	timeout time.Duration,
+	jsonConverter *jsonconv.JSONConverter,
+	logger log.Logger,
+) *Mirror {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	return &Mirror{
+		chainID:       chainID,
+		endpoint:      endpoint,
+		conn:          conn,
+		percent:       percent,
+		timeout:       timeout,
+		slots:         make(chan struct{}, maxInFlight),
+		jsonConverter: jsonConverter,
+		logger:        logger,
+	}
+}
+
+// ChainID returns the chain whose upstream calls are mirrored.
+func (m *Mirror) ChainID() string {
+	return m.chainID
+}
+
+// Endpoint returns the address of the candidate upstream.
+func (m *Mirror) Endpoint() string {
+	return m.endpoint
+}
+
+// Percent returns the share of mirrored calls from 0 to 100.
+func (m *Mirror) Percent() float64 {
+	return m.percent
+}
+
+// Stats returns the counts of mirrored calls so far.
+func (m *Mirror) Stats() Stats {
+	return Stats{
+		Mirrored:   m.mirrored.Load(),
+		Matched:    m.matched.Load(),
+		Mismatched: m.mismatched.Load(),
+		Failed:     m.failed.Load(),
+		Dropped:    m.dropped.Load(),
+	}
+}
+
+// Wait blocks until all mirrored calls in flight are done.
+func (m *Mirror) Wait() {
+	m.wg.Wait()
+}
+
+// Interceptor returns a gRPC client interceptor for the primary upstream connections which mirrors
+// a sample of their calls marked with WithMirroring.
+func (m *Mirror) Interceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req any,
+		reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		errResp := invoker(ctx, method, req, reply, cc, opts...)
+
+		if !mirroringEnabled(ctx) || _neverMirrored[method] || rand.Float64()*100 >= m.percent { //nolint:gosec
+			return errResp
+		}
+
+		// The request and reply belong to the caller once we return, so the mirrored call works on copies.
+		request, err := proto.Marshal(req.(proto.Message))
+		if err != nil {
+			return errResp
+		}
+
+		var primary []byte
+
+		if errResp == nil {
+			if primary, err = proto.Marshal(reply.(proto.Message)); err != nil {
+				return errResp
+			}
+		}
+
+		select {
+		case m.slots <- struct{}{}:
+		default:
+			m.dropped.Add(1)
+
+			return errResp
+		}
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+
+		m.wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-m.slots
+				m.wg.Done()
+			}()
+
+			m.mirror(md, method, req, request, reply, primary, status.Code(errResp))
+		}()
+
+		return errResp
+	}
+}
+
+func (m *Mirror) mirror(
+	md metadata.MD,
+	method string,
+	req any,
+	request []byte,
+	reply any,
+	primary []byte,
+	primaryCode codes.Code,
+) {
+	m.mirrored.Add(1)
+
+	logger := m.logger.With(log.String("chain_id", m.chainID), log.String("method", method))
+
+	candidateReq := newMessage(req)
+	if err := proto.Unmarshal(request, candidateReq); err != nil {
+		m.failed.Add(1)
+		logger.Error("cannot decode mirrored request", log.Error(err))
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), m.timeout)
+	defer cancel()
+
+	candidateReply := newMessage(reply)
+	candidateCode := status.Code(m.conn.Invoke(ctx, method, candidateReq, candidateReply))
+
+	switch {
+	case candidateCode == codes.DeadlineExceeded || candidateCode == codes.Unavailable:
+		m.failed.Add(1)
+		logger.Warn("mirrored call failed", log.String("code", candidateCode.String()))
+
+		return
+	case candidateCode != primaryCode:
+		m.mismatched.Add(1)
+		logger.Warn("mirrored call mismatch",
+			log.String("primary_code", primaryCode.String()),
+			log.String("candidate_code", candidateCode.String()),
+		)
+
+		return
+	case primaryCode != codes.OK:
+		m.matched.Add(1)
+
+		return
+	}
+
+	diffs, err := m.compare(reply, primary, candidateReply)
+	if err != nil {
+		m.failed.Add(1)
+		logger.Error("cannot compare mirrored call", log.Error(err))
+
+		return
+	}
+
+	if len(diffs) == 0 {
+		m.matched.Add(1)
+
+		return
+	}
+
+	m.mismatched.Add(1)
+
+	logged := diffs
+	if len(logged) > _maxLoggedDifferences {
+		logged = logged[:_maxLoggedDifferences]
+	}
+
+	differences := make([]string, 0, len(logged))
+	for _, d := range logged {
+		differences = append(differences, d.String())
+	}
+
+	logger.Warn("mirrored call mismatch",
+		log.Int("difference_count", len(diffs)),
+		log.Strings("differences", differences),
+	)
+}
+
+// compare renders both responses canonically as JSON and returns their differences.
+func (m *Mirror) compare(reply any, primary []byte, candidateReply proto.Message) ([]jsondiff.Difference, error) {
+	primaryReply := newMessage(reply)
+	if err := proto.Unmarshal(primary, primaryReply); err != nil {
+		return nil, err
+	}
+
+	primaryJSON, err := m.jsonConverter.Marshal(primaryReply)
+	if err != nil {
+		return nil, err
+	}
+
+	candidateJSON, err := m.jsonConverter.Marshal(candidateReply)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsondiff.Compare(primaryJSON, candidateJSON)
+}
+
+// newMessage returns a new empty message of the type of msg.
+func newMessage(msg any) proto.Message {
+	return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(proto.Message)
+}
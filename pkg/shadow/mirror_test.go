@@ -0,0 +1,112 @@
+package shadow_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/shadow"
+)
+
+func TestMirror(t *testing.T) {
+	ctx := shadow.WithMirroring(context.Background())
+
+	var buf bytes.Buffer
+
+	logger := log.New(log.WithLogToStdout(false), log.WithOutput(&buf))
+
+	primary := testrunner.NewFakeUpstream("test-1", 10, 3)
+	candidate := testrunner.NewFakeUpstream("test-1", 10, 3)
+	primaryConn := primary.Conn(t)
+
+	mirror := shadow.NewMirror("test-1", "candidate", candidate.Conn(t), 100, 4, time.Second,
+		jsonconv.NewJSONConverter(), logger)
+	interceptor := mirror.Interceptor()
+
+	invokeWithContext := func(ctx context.Context, method string, req, reply any) error {
+		return interceptor(ctx, method, req, reply, nil,
+			func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				return primaryConn.Invoke(ctx, method, req, reply, opts...)
+			})
+	}
+
+	invoke := func(method string, req, reply any) error {
+		return invokeWithContext(ctx, method, req, reply)
+	}
+
+	const getLatestBlock = "/cosmos.base.tendermint.v1beta1.Service/GetLatestBlock"
+
+	if err := invoke(getLatestBlock, &tmservice.GetLatestBlockRequest{}, &tmservice.GetLatestBlockResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	mirror.Wait()
+
+	candidate.AddBlocks(1)
+
+	reply := &tmservice.GetLatestBlockResponse{}
+	if err := invoke(getLatestBlock, &tmservice.GetLatestBlockRequest{}, reply); err != nil {
+		t.Fatal(err)
+	}
+
+	if reply.SdkBlock.Header.Height != 10 {
+		t.Errorf("expected the primary response, got height %d", reply.SdkBlock.Header.Height)
+	}
+
+	candidate.FailWith("GetSyncing", status.Error(codes.Unavailable, "down"))
+
+	err := invoke("/cosmos.base.tendermint.v1beta1.Service/GetSyncing", &tmservice.GetSyncingRequest{},
+		&tmservice.GetSyncingResponse{})
+	if err != nil {
+		t.Errorf("expected the candidate failure not to reach the caller, got %v", err)
+	}
+
+	primary.FailWith("BroadcastTx", status.Error(codes.FailedPrecondition, "rejected"))
+
+	err = invoke("/cosmos.tx.v1beta1.Service/BroadcastTx", &txtypes.BroadcastTxRequest{}, &txtypes.BroadcastTxResponse{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected the primary error, got %v", err)
+	}
+
+	// Calls without the mirroring mark, like the health checks of the upstream guard, stay on the primary.
+	err = invokeWithContext(context.Background(), "/cosmos.base.tendermint.v1beta1.Service/GetNodeInfo",
+		&tmservice.GetNodeInfoRequest{}, &tmservice.GetNodeInfoResponse{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The caller may reuse the request once the call returned, without affecting the mirrored call.
+	req := &tmservice.GetBlockByHeightRequest{Height: 5}
+	if err := invoke("/cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight", req,
+		&tmservice.GetBlockByHeightResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	req.Height = 999
+
+	mirror.Wait()
+
+	if candidate.Calls("GetNodeInfo") != 0 {
+		t.Errorf("expected unmarked calls not to be mirrored, got %d candidate calls", candidate.Calls("GetNodeInfo"))
+	}
+
+	want := shadow.Stats{Mirrored: 4, Matched: 2, Mismatched: 1, Failed: 1}
+	if got := mirror.Stats(); got != want {
+		t.Errorf("expected stats %+v, got %+v", want, got)
+	}
+
+	if !strings.Contains(buf.String(), "mirrored call mismatch") || !strings.Contains(buf.String(), "header.height") {
+		t.Errorf("expected the height difference to be logged, got %s", buf.String())
+	}
+}
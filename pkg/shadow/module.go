@@ -0,0 +1,57 @@
+package shadow
+
+import (
+	"context"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+// InitializeMirror dials the SHADOW_UPSTREAM candidate for the chain SHADOW_CHAIN_ID, or
+// DEFAULT_CHAIN_ID when unset. It returns nil when shadow traffic is disabled.
+func InitializeMirror(
+	ctx context.Context,
+	conf *configs.Config,
+	logger log.Logger,
+	jsonConverter *jsonconv.JSONConverter,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) *Mirror {
+	if conf.ShadowUpstream == "" || conf.ShadowPercent <= 0 {
+		return nil
+	}
+
+	if conf.ShadowPercent > 100 {
+		logger.Panic("error: SHADOW_PERCENT must be between 0 and 100")
+	}
+
+	chainID := conf.ShadowChainID
+	if chainID == "" {
+		chainID = conf.DefaultChainID
+	}
+
+	conn, err := client.NewDefaultGRPCConn(
+		ctx,
+		logger.Named("shadow.grpc.client"),
+		jsonConverter,
+		interfaceRegistry,
+		conf.ShadowUpstream,
+	)
+	if err != nil {
+		logger.Panic("error: cannot create gRPC connection to shadow upstream: ", log.Error(err))
+	}
+
+	return NewMirror(
+		chainID,
+		conf.ShadowUpstream,
+		conn,
+		conf.ShadowPercent,
+		conf.ShadowMaxInFlight,
+		conf.ShadowTimeout,
+		jsonConverter,
+		logger.Named("shadow"),
+	)
+}
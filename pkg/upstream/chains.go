@@ -0,0 +1,61 @@
+package upstream
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ChainConfig lists the upstream endpoints serving a single chain.
+type ChainConfig struct {
+	ChainID   string
+	Endpoints []string
+}
+
+// ParseChains parses chain specs in the format "chain-id=endpoint,endpoint". A spec without
+// a chain ID, e.g. "endpoint,endpoint", is returned with an empty ChainID. Endpoints of specs
+// with the same chain ID are merged in order.
+func ParseChains(specs []string) ([]ChainConfig, error) {
+	chains := make([]ChainConfig, 0, len(specs))
+	index := make(map[string]int)
+
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+
+		var chain ChainConfig
+
+		endpoints := spec
+		if idx := strings.IndexByte(spec, '='); idx >= 0 {
+			chain.ChainID = strings.TrimSpace(spec[:idx])
+			endpoints = spec[idx+1:]
+
+			if chain.ChainID == "" {
+				return nil, errors.Errorf("invalid chain spec %q: empty chain ID", spec)
+			}
+		}
+
+		for _, endpoint := range strings.Split(endpoints, ",") {
+			if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+				chain.Endpoints = append(chain.Endpoints, endpoint)
+			}
+		}
+
+		if len(chain.Endpoints) == 0 {
+			return nil, errors.Errorf("invalid chain spec %q: no endpoints", spec)
+		}
+
+		if idx, ok := index[chain.ChainID]; ok {
+			chains[idx].Endpoints = append(chains[idx].Endpoints, chain.Endpoints...)
+
+			continue
+		}
+
+		index[chain.ChainID] = len(chains)
+		chains = append(chains, chain)
+	}
+
+	return chains, nil
+}
@@ -0,0 +1,102 @@
+package upstream
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+)
+
+const _checkTimeout = 10 * time.Second
+
+// CheckFunc checks a single upstream of a chain. Errors wrapping ErrChainIDMismatch or ErrVersionMismatch
+// mean that the upstream is inconsistent, any other error that it couldn't be checked.
+type CheckFunc func(ctx context.Context, chainID string, u *Upstream) error
+
+// Guard periodically checks every upstream and quarantines the ones serving another chain
+// or running a Cosmos SDK version outside the allowed range.
+// Quarantined upstreams are released as soon as they pass a check again, e.g. after an upgrade.
+// Unreachable upstreams are left as they are, since being unreachable says nothing about their consistency.
+type Guard struct {
+	router        *Router
+	checkUpstream CheckFunc
+	interval      time.Duration
+	logger        log.Logger
+}
+
+// NewGuard is a constructor function for Guard. Upstreams are checked with CheckUpstream.
+func NewGuard(router *Router, versions VersionRange, interval time.Duration, logger log.Logger) *Guard {
+	return NewGuardWithCheck(router, func(ctx context.Context, chainID string, u *Upstream) error {
+		return CheckUpstream(ctx, chainID, versions, u)
+	}, interval, logger)
+}
+
+// NewGuardWithCheck is a constructor function for Guard checking upstreams with checkUpstream,
+// for upstreams which don't speak gRPC.
+func NewGuardWithCheck(router *Router, checkUpstream CheckFunc, interval time.Duration, logger log.Logger) *Guard {
+	return &Guard{
+		router:        router,
+		checkUpstream: checkUpstream,
+		interval:      interval,
+		logger:        logger,
+	}
+}
+
+// Check runs the consistency check once on every upstream.
+func (g *Guard) Check(ctx context.Context) {
+	for _, pool := range g.router.Pools() {
+		for _, u := range pool.Upstreams() {
+			g.check(ctx, pool.ChainID, u)
+		}
+	}
+}
+
+// Run checks the upstreams every interval until ctx is done. A zero interval disables periodic checks.
+func (g *Guard) Run(ctx context.Context) {
+	if g.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			g.Check(ctx)
+		}
+	}
+}
+
+func (g *Guard) check(ctx context.Context, chainID string, u *Upstream) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, _checkTimeout)
+	defer cancel()
+
+	err := g.checkUpstream(ctxWithTimeout, chainID, u)
+	reason, quarantined := u.Quarantined()
+
+	fields := []log.Field{
+		log.String("chain_id", chainID),
+		log.String("endpoint", u.Endpoint),
+	}
+
+	switch {
+	case err == nil:
+		if quarantined {
+			u.Release()
+			g.logger.Info("upstream released from quarantine", append(fields, log.String("reason", reason))...)
+		}
+	case errors.Is(err, ErrChainIDMismatch), errors.Is(err, ErrVersionMismatch):
+		u.Quarantine(err.Error())
+
+		if !quarantined {
+			g.logger.Error("upstream quarantined", append(fields, log.Error(err))...)
+		}
+	default:
+		g.logger.Warn("cannot check upstream", append(fields, log.Error(err))...)
+	}
+}
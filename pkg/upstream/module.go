@@ -0,0 +1,96 @@
+package upstream
+
+import (
+	"context"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"google.golang.org/grpc"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/configs"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/client"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/replay"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/jsonconv"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/shadow"
+)
+
+// InitializeRouter dials the gRPC upstreams of every configured chain, checks them once and
+// keeps checking them periodically in the background. Without CHAINS, COSMOS_SDK_GRPC_ENDPOINT
+// is used as the single upstream of DEFAULT_CHAIN_ID, and its network is only checked
+// when DEFAULT_CHAIN_ID is set. With UPSTREAM_RECORD_DIR every upstream call is recorded
+// as a replay fixture. A non-nil mirror receives a sample of the calls of its chain.
+func InitializeRouter(
+	ctx context.Context,
+	conf *configs.Config,
+	logger log.Logger,
+	jsonConverter *jsonconv.JSONConverter,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	mirror *shadow.Mirror,
+) *Router {
+	specs := conf.Chains
+	if len(specs) == 0 {
+		specs = []string{conf.CosmosSDKGRPCEndpoint}
+
+		if conf.DefaultChainID != "" {
+			specs[0] = conf.DefaultChainID + "=" + conf.CosmosSDKGRPCEndpoint
+		}
+	}
+
+	chains, err := ParseChains(specs)
+	if err != nil {
+		logger.Panic("error: invalid chain config: ", log.Error(err))
+	}
+
+	versions, err := ParseVersionRange(conf.CosmosSDKVersionRange)
+	if err != nil {
+		logger.Panic("error: invalid Cosmos SDK version range: ", log.Error(err))
+	}
+
+	var interceptors []grpc.UnaryClientInterceptor
+
+	if conf.UpstreamRecordDir != "" {
+		interceptors = append(interceptors,
+			replay.NewRecordingInterceptor(conf.UpstreamRecordDir, jsonConverter, logger.Named("replay")))
+	}
+
+	pools := make([]*Pool, 0, len(chains))
+
+	for _, chain := range chains {
+		upstreams := make([]*Upstream, 0, len(chain.Endpoints))
+
+		chainInterceptors := interceptors
+		if mirror != nil && mirror.ChainID() == chain.ChainID {
+			chainInterceptors = append(chainInterceptors[:len(chainInterceptors):len(chainInterceptors)], mirror.Interceptor())
+		}
+
+		for _, endpoint := range chain.Endpoints {
+			grpcConn, err := client.NewDefaultGRPCConn(
+				ctx,
+				logger.Named("grpc.client"),
+				jsonConverter,
+				interfaceRegistry,
+				endpoint,
+				chainInterceptors...,
+			)
+			if err != nil {
+				logger.Panic("error: cannot create gRPC connection to Cosmos SDK endpoint: ", log.Error(err))
+			}
+
+			upstreams = append(upstreams, &Upstream{
+				Endpoint: endpoint,
+				Conn:     grpcConn,
+			})
+		}
+
+		pools = append(pools, NewPool(chain.ChainID, upstreams...))
+	}
+
+	router := NewRouter(conf.DefaultChainID, pools...)
+
+	guard := NewGuard(router, versions, conf.UpstreamCheckInterval, logger.Named("upstream"))
+	guard.Check(ctx)
+
+	go guard.Run(ctx)
+
+	return router
+}
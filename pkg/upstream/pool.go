@@ -0,0 +1,100 @@
+package upstream
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Upstream is a single endpoint of a chain. Conn is only set for gRPC endpoints.
+type Upstream struct {
+	Endpoint string
+	Conn     grpc.ClientConnInterface
+
+	mu               sync.RWMutex
+	quarantineReason string
+}
+
+// Quarantine takes the upstream out of rotation until it is released.
+func (u *Upstream) Quarantine(reason string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.quarantineReason = reason
+}
+
+// Release puts a quarantined upstream back into rotation.
+func (u *Upstream) Release() {
+	u.Quarantine("")
+}
+
+// Quarantined reports whether the upstream is out of rotation and why.
+func (u *Upstream) Quarantined() (string, bool) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.quarantineReason, u.quarantineReason != ""
+}
+
+// Pool holds the upstreams serving one chain.
+type Pool struct {
+	ChainID   string
+	upstreams []*Upstream
+	next      atomic.Uint64
+}
+
+// NewPool is a constructor function for Pool.
+func NewPool(chainID string, upstreams ...*Upstream) *Pool {
+	return &Pool{
+		ChainID:   chainID,
+		upstreams: upstreams,
+	}
+}
+
+// Conn returns the connection of the next upstream in round-robin order. Quarantined upstreams are skipped.
+func (p *Pool) Conn() (grpc.ClientConnInterface, error) {
+	u, err := p.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	return u.Conn, nil
+}
+
+// Next returns the next upstream in round-robin order. Quarantined upstreams are skipped.
+func (p *Pool) Next() (*Upstream, error) {
+	start := p.next.Add(1) - 1
+
+	for i := uint64(0); i < uint64(len(p.upstreams)); i++ {
+		u := p.upstreams[(start+i)%uint64(len(p.upstreams))]
+		if _, quarantined := u.Quarantined(); !quarantined {
+			return u, nil
+		}
+	}
+
+	return nil, p.unavailable()
+}
+
+// Primary returns the connection of the first upstream which is not quarantined. Calls which
+// must not be spread over several upstreams, like transaction broadcasts, are pinned to it.
+func (p *Pool) Primary() (grpc.ClientConnInterface, error) {
+	for _, u := range p.upstreams {
+		if _, quarantined := u.Quarantined(); !quarantined {
+			return u.Conn, nil
+		}
+	}
+
+	return nil, p.unavailable()
+}
+
+// Upstreams returns all upstreams of the pool.
+func (p *Pool) Upstreams() []*Upstream {
+	return p.upstreams
+}
+
+func (p *Pool) unavailable() error {
+	return status.Errorf(codes.Unavailable, "all upstreams of chain %q are quarantined", p.ChainID)
+}
@@ -0,0 +1,86 @@
+package upstream
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ChainIDHeader is the metadata key used to select the chain a call is routed to.
+const ChainIDHeader = "x-chain-id"
+
+// Router routes calls to the upstream pool of the chain selected by the x-chain-id header.
+type Router struct {
+	pools          map[string]*Pool
+	defaultChainID string
+}
+
+// NewRouter is a constructor function for Router. Calls without the x-chain-id header are routed
+// to the default chain. With a single pool and no explicit default, that pool becomes the default.
+func NewRouter(defaultChainID string, pools ...*Pool) *Router {
+	r := &Router{
+		pools:          make(map[string]*Pool, len(pools)),
+		defaultChainID: defaultChainID,
+	}
+
+	for _, p := range pools {
+		r.pools[p.ChainID] = p
+	}
+
+	if r.defaultChainID == "" && len(pools) == 1 {
+		r.defaultChainID = pools[0].ChainID
+	}
+
+	return r
+}
+
+// Route returns the pool for the chain selected in the incoming metadata of ctx.
+func (r *Router) Route(ctx context.Context) (*Pool, error) {
+	return r.Pool(ChainIDFromContext(ctx))
+}
+
+// Pool returns the pool for the given chain ID. An empty chain ID selects the default chain.
+func (r *Router) Pool(chainID string) (*Pool, error) {
+	if chainID == "" {
+		pool, ok := r.pools[r.defaultChainID]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing %s header", ChainIDHeader)
+		}
+
+		return pool, nil
+	}
+
+	pool, ok := r.pools[chainID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown chain ID %q", chainID)
+	}
+
+	return pool, nil
+}
+
+// Pools returns all pools sorted by chain ID.
+func (r *Router) Pools() []*Pool {
+	pools := make([]*Pool, 0, len(r.pools))
+	for _, p := range r.pools {
+		pools = append(pools, p)
+	}
+
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].ChainID < pools[j].ChainID
+	})
+
+	return pools
+}
+
+// ChainIDFromContext returns the chain ID from the incoming metadata of ctx or an empty string.
+func ChainIDFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vals := md.Get(ChainIDHeader); len(vals) > 0 {
+		return vals[0]
+	}
+
+	return ""
+}
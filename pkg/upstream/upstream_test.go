@@ -0,0 +1,228 @@
+package upstream_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/grpc/testrunner"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/log"
+	"github.com/powerslider/cosmos-grpc-forwarder/pkg/upstream"
+)
+
+// newFakeConn connects to a fake upstream which reports network and sdkVersion.
+func newFakeConn(t *testing.T, network, sdkVersion string) *grpc.ClientConn {
+	t.Helper()
+
+	fake := testrunner.NewFakeUpstream(network, 1, 1)
+	fake.SetCosmosSDKVersion(sdkVersion)
+
+	return fake.Conn(t)
+}
+
+func TestParseChains(t *testing.T) {
+	chains, err := upstream.ParseChains([]string{
+		"osmosis-1=grpc.osmosis.zone:9090, osmosis.backup:9090",
+		"cosmoshub-4=grpc.cosmos.network:9090",
+		"osmosis-1=osmosis.third:9090",
+		"localhost:9090",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []upstream.ChainConfig{
+		{ChainID: "osmosis-1", Endpoints: []string{"grpc.osmosis.zone:9090", "osmosis.backup:9090", "osmosis.third:9090"}},
+		{ChainID: "cosmoshub-4", Endpoints: []string{"grpc.cosmos.network:9090"}},
+		{ChainID: "", Endpoints: []string{"localhost:9090"}},
+	}
+
+	if diff := cmp.Diff(want, chains); diff != "" {
+		t.Errorf("unexpected chains (-want +got):\n%s", diff)
+	}
+
+	for _, spec := range []string{"=localhost:9090", "osmosis-1=", "osmosis-1= , "} {
+		if _, err := upstream.ParseChains([]string{spec}); err == nil {
+			t.Errorf("expected error for spec %q", spec)
+		}
+	}
+}
+
+func TestRouterRoute(t *testing.T) {
+	osmosis := upstream.NewPool("osmosis-1",
+		&upstream.Upstream{Endpoint: "a", Conn: newFakeConn(t, "osmosis-1", "v0.47.2")},
+		&upstream.Upstream{Endpoint: "b", Conn: newFakeConn(t, "osmosis-1", "v0.47.2")},
+	)
+	hub := upstream.NewPool("cosmoshub-4", &upstream.Upstream{Endpoint: "c", Conn: newFakeConn(t, "cosmoshub-4", "v0.47.2")})
+
+	router := upstream.NewRouter("", osmosis, hub)
+
+	withChainID := func(chainID string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(upstream.ChainIDHeader, chainID))
+	}
+
+	pool, err := router.Route(withChainID("cosmoshub-4"))
+	if err != nil || pool != hub {
+		t.Errorf("expected cosmoshub-4 pool, got %v, %v", pool, err)
+	}
+
+	if _, err := router.Route(withChainID("juno-1")); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for unknown chain, got %v", err)
+	}
+
+	if _, err := router.Route(context.Background()); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument without chain ID and default, got %v", err)
+	}
+
+	pool, err = upstream.NewRouter("osmosis-1", osmosis, hub).Route(context.Background())
+	if err != nil || pool != osmosis {
+		t.Errorf("expected default osmosis-1 pool, got %v, %v", pool, err)
+	}
+
+	first, _ := osmosis.Conn()
+	second, _ := osmosis.Conn()
+
+	if first == second {
+		t.Error("expected pool connections to be picked in round-robin order")
+	}
+}
+
+func TestPoolQuarantine(t *testing.T) {
+	a := &upstream.Upstream{Endpoint: "a", Conn: newFakeConn(t, "osmosis-1", "v0.47.2")}
+	b := &upstream.Upstream{Endpoint: "b", Conn: newFakeConn(t, "osmosis-1", "v0.47.2")}
+	pool := upstream.NewPool("osmosis-1", a, b)
+
+	a.Quarantine("wrong network")
+
+	for i := 0; i < 3; i++ {
+		if conn, err := pool.Conn(); err != nil || conn != b.Conn {
+			t.Fatalf("expected quarantined upstream to be skipped, got %v, %v", conn, err)
+		}
+	}
+
+	if conn, err := pool.Primary(); err != nil || conn != b.Conn {
+		t.Errorf("expected primary to fall back to the next upstream, got %v, %v", conn, err)
+	}
+
+	b.Quarantine("wrong version")
+
+	if _, err := pool.Conn(); status.Code(err) != codes.Unavailable {
+		t.Errorf("expected Unavailable with all upstreams quarantined, got %v", err)
+	}
+
+	a.Release()
+
+	if conn, err := pool.Primary(); err != nil || conn != a.Conn {
+		t.Errorf("expected released upstream to be back in rotation, got %v, %v", conn, err)
+	}
+}
+
+func TestCheckUpstream(t *testing.T) {
+	ctx := context.Background()
+
+	versions, err := upstream.ParseVersionRange(">=v0.47.0,<v0.48.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		chainID    string
+		network    string
+		sdkVersion string
+		want       error
+	}{
+		{name: "match", chainID: "osmosis-1", network: "osmosis-1", sdkVersion: "v0.47.2"},
+		{name: "no chain ID", network: "osmosis-1", sdkVersion: "0.47.3"},
+		{
+			name:       "wrong network",
+			chainID:    "osmosis-1",
+			network:    "cosmoshub-4",
+			sdkVersion: "v0.47.2",
+			want:       upstream.ErrChainIDMismatch,
+		},
+		{
+			name:       "wrong version",
+			chainID:    "osmosis-1",
+			network:    "osmosis-1",
+			sdkVersion: "v0.46.12",
+			want:       upstream.ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := newFakeConn(t, tt.network, tt.sdkVersion)
+
+			err := upstream.CheckUpstream(ctx, tt.chainID, versions, &upstream.Upstream{Endpoint: "a", Conn: conn})
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestVersionRange(t *testing.T) {
+	tests := []struct {
+		spec    string
+		version string
+		allowed bool
+	}{
+		{spec: "", version: "anything", allowed: true},
+		{spec: ">=v0.47.0,<v0.48.0", version: "v0.47.2", allowed: true},
+		{spec: ">=v0.47.0,<v0.48.0", version: "v0.48.0", allowed: false},
+		{spec: ">=0.47.0", version: "0.50.1", allowed: true},
+		{spec: ">v0.47.0", version: "v0.47.0", allowed: false},
+		{spec: "<=v0.47.0", version: "v0.47.0", allowed: true},
+		{spec: "v0.47.2", version: "v0.47.2+build", allowed: true},
+		{spec: ">=v0.47.0", version: "", allowed: false},
+	}
+
+	for _, tt := range tests {
+		versions, err := upstream.ParseVersionRange(tt.spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := versions.Allows(tt.version); got != tt.allowed {
+			t.Errorf("%q allows %q: expected %v, got %v", tt.spec, tt.version, tt.allowed, got)
+		}
+	}
+
+	if _, err := upstream.ParseVersionRange(">=latest"); err == nil {
+		t.Error("expected error for invalid version")
+	}
+}
+
+func TestGuardCheck(t *testing.T) {
+	good := &upstream.Upstream{Endpoint: "good", Conn: newFakeConn(t, "osmosis-1", "v0.47.2")}
+	fake := testrunner.NewFakeUpstream("osmosis-1", 1, 1)
+	fake.SetNetwork("cosmoshub-4")
+	bad := &upstream.Upstream{Endpoint: "bad", Conn: fake.Conn(t)}
+
+	router := upstream.NewRouter("", upstream.NewPool("osmosis-1", good, bad))
+	guard := upstream.NewGuard(router, upstream.VersionRange{}, 0, log.InitializeLogger("error", "json"))
+
+	guard.Check(context.Background())
+
+	if _, quarantined := good.Quarantined(); quarantined {
+		t.Error("expected matching upstream not to be quarantined")
+	}
+
+	if _, quarantined := bad.Quarantined(); !quarantined {
+		t.Error("expected upstream serving another network to be quarantined")
+	}
+
+	fake.SetNetwork("osmosis-1")
+	guard.Check(context.Background())
+
+	if _, quarantined := bad.Quarantined(); quarantined {
+		t.Error("expected fixed upstream to be released")
+	}
+}
@@ -0,0 +1,35 @@
+package upstream
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrChainIDMismatch is returned when an upstream serves a different network than its configured chain ID.
+	ErrChainIDMismatch = errors.New("chain ID mismatch")
+	// ErrVersionMismatch is returned when an upstream runs a Cosmos SDK version outside the allowed range.
+	ErrVersionMismatch = errors.New("cosmos SDK version mismatch")
+)
+
+// CheckUpstream calls GetNodeInfo on the upstream and checks that it serves the given chain ID
+// and runs a Cosmos SDK version within the allowed range. An empty chain ID skips the network check.
+// Errors other than ErrChainIDMismatch and ErrVersionMismatch mean that the upstream couldn't be queried.
+func CheckUpstream(ctx context.Context, chainID string, versions VersionRange, u *Upstream) error {
+	resp, err := tmservice.NewServiceClient(u.Conn).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
+	if err != nil {
+		return errors.Wrapf(err, "cannot query node info of %s", u.Endpoint)
+	}
+
+	if network := resp.GetDefaultNodeInfo().GetNetwork(); chainID != "" && network != chainID {
+		return errors.Wrapf(ErrChainIDMismatch, "%s serves %q instead of %q", u.Endpoint, network, chainID)
+	}
+
+	if version := resp.GetApplicationVersion().GetCosmosSdkVersion(); !versions.Allows(version) {
+		return errors.Wrapf(ErrVersionMismatch, "%s runs %q outside of %q", u.Endpoint, version, versions)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package upstream
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
+)
+
+// versionConstraint is a single comparison like ">=v0.47.0".
+type versionConstraint struct {
+	op      string
+	version string
+}
+
+// VersionRange is a set of semantic version constraints which all have to match.
+// An empty VersionRange allows any version.
+type VersionRange struct {
+	raw         string
+	constraints []versionConstraint
+}
+
+// ParseVersionRange parses comma separated constraints like ">=v0.47.0,<v0.48.0".
+// Supported operators are >=, >, <=, < and =. A version without an operator must match exactly.
+func ParseVersionRange(spec string) (VersionRange, error) {
+	r := VersionRange{
+		raw: strings.TrimSpace(spec),
+	}
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var c versionConstraint
+
+		for _, op := range []string{">=", "<=", ">", "<", "="} {
+			if strings.HasPrefix(part, op) {
+				c.op = op
+				part = strings.TrimSpace(part[len(op):])
+
+				break
+			}
+		}
+
+		if c.op == "" {
+			c.op = "="
+		}
+
+		c.version = canonicalVersion(part)
+		if c.version == "" {
+			return VersionRange{}, errors.Errorf("invalid version constraint %q: %q is not a semantic version", spec, part)
+		}
+
+		r.constraints = append(r.constraints, c)
+	}
+
+	return r, nil
+}
+
+// Allows reports whether the version satisfies all constraints of the range.
+func (r VersionRange) Allows(version string) bool {
+	if len(r.constraints) == 0 {
+		return true
+	}
+
+	v := canonicalVersion(version)
+	if v == "" {
+		return false
+	}
+
+	for _, c := range r.constraints {
+		cmp := semver.Compare(v, c.version)
+
+		var ok bool
+
+		switch c.op {
+		case ">=":
+			ok = cmp >= 0
+		case ">":
+			ok = cmp > 0
+		case "<=":
+			ok = cmp <= 0
+		case "<":
+			ok = cmp < 0
+		default:
+			ok = cmp == 0
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the range as it was configured.
+func (r VersionRange) String() string {
+	return r.raw
+}
+
+// canonicalVersion converts versions like "0.47.2" or "v0.47.2+build" to the "v0.47.2" form
+// expected by semver. It returns an empty string for invalid versions.
+func canonicalVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if version != "" && !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	return semver.Canonical(version)
+}